@@ -0,0 +1,132 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+// getDerivativeTestActivations returns every registered activation, plus the parameterized
+// ones with non-default parameters.
+func getDerivativeTestActivations() []IActivation {
+	var activations []IActivation
+	for _, gen := range registry {
+		act, err := gen("{}")
+		if err != nil {
+			panic(err)
+		}
+		activations = append(activations, act)
+	}
+
+	return append(activations,
+		NewLeakyReLU(0.2),
+		NewELU(0.5),
+		NewSwish(2),
+		NewGELU(true),
+	)
+}
+
+// Points are kept away from 0, where ReLU-like activations have a kink.
+var derivativeTestPoints = []float64{-3.1, -1.2, -0.4, -0.05, 0.07, 0.3, 1.5, 4.2}
+
+func TestDerivateMatchesFiniteDifference(t *testing.T) {
+	const h = 1e-6
+	for _, act := range getDerivativeTestActivations() {
+		if _, ok := act.(IVectorActivation); ok {
+			continue
+		}
+
+		for _, z := range derivativeTestPoints {
+			expected := (act.Activate(z+h) - act.Activate(z-h)) / (2 * h)
+			got := act.Derivate(z, act.Activate(z))
+			if math.Abs(got-expected) > 1e-5 {
+				t.Errorf("%s at %f: Expected %f, get %f", act.CallMe(), z, expected, got)
+			}
+		}
+	}
+}
+
+func TestBackwardVectorMatchesFiniteDifference(t *testing.T) {
+	const h = 1e-6
+	sums := []float64{0.5, -1.2, 2.0, 0.1}
+	grad := []float64{0.3, -0.7, 1.1, 0.2}
+
+	// With the loss L = sum(grad * outputs), dL/dz_i is what BackwardVector should return
+	lossOf := func(act IVectorActivation, sums []float64) (result float64) {
+		for i, val := range act.ActivateVector(sums) {
+			result += grad[i] * val
+		}
+		return result
+	}
+
+	for _, act := range getDerivativeTestActivations() {
+		vectorFn, ok := act.(IVectorActivation)
+		if !ok {
+			continue
+		}
+
+		outputs := vectorFn.ActivateVector(sums)
+		got := vectorFn.BackwardVector(sums, outputs, append([]float64(nil), grad...))
+		for i := range sums {
+			plus := append([]float64(nil), sums...)
+			minus := append([]float64(nil), sums...)
+			plus[i] += h
+			minus[i] -= h
+
+			expected := (lossOf(vectorFn, plus) - lossOf(vectorFn, minus)) / (2 * h)
+			if math.Abs(got[i]-expected) > 1e-5 {
+				t.Errorf("%s at %d: Expected %f, get %f", act.CallMe(), i, expected, got[i])
+			}
+		}
+	}
+}
+
+func TestParamGradientMatchesFiniteDifference(t *testing.T) {
+	const h = 1e-6
+	sums := derivativeTestPoints
+	grad := make([]float64, len(sums))
+	for i := range grad {
+		grad[i] = float64(i+1) / 10
+	}
+
+	// With the loss L = sum(grad * outputs), dL/dparam is what ParamGradient should return
+	lossOf := func(act IActivation) (result float64) {
+		for i, z := range sums {
+			result += grad[i] * act.Activate(z)
+		}
+		return result
+	}
+
+	for _, act := range getDerivativeTestActivations() {
+		learnable, ok := act.(ILearnable)
+		if !ok {
+			continue
+		}
+
+		outputs := make([]float64, len(sums))
+		for i, z := range sums {
+			outputs[i] = act.Activate(z)
+		}
+		got := learnable.ParamGradient(sums, outputs, grad)
+
+		params := learnable.Params()
+		for j := range params {
+			original := params[j]
+
+			params[j] = original + h
+			learnable.SetParams(params)
+			plus := lossOf(act)
+
+			params[j] = original - h
+			learnable.SetParams(params)
+			minus := lossOf(act)
+
+			params[j] = original
+			learnable.SetParams(params)
+
+			expected := (plus - minus) / (2 * h)
+			if math.Abs(got[j]-expected) > 1e-5 {
+				t.Errorf("%s param %d: Expected %f, get %f", act.CallMe(), j, expected, got[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+func getELUExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-0.5: -0.393469,
+		0.02: 0.02,
+		-2:   -0.864665,
+		0.25: 0.25,
+	}
+}
+
+func TestActivateELU(t *testing.T) {
+	activation := NewELU(1)
+	vals := getELUExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func TestDerivateELU(t *testing.T) {
+	activation := NewELU(1)
+	expected := math.Exp(-0.5)
+	got := activation.Derivate(-0.5, activation.Activate(-0.5))
+	if math.Abs(got-expected) > 0.0001 {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
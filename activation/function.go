@@ -1,8 +1,10 @@
 package activation
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/harungurubudi/rolade/model"
 )
@@ -12,9 +14,60 @@ import (
 // properties and returns an IActivation implementation. It is used to
 // reconstruct activation functions from profile metadata.
 var registry = map[string]func(string) (IActivation, error){
-	"relu":    func(_ string) (IActivation, error) { return &ReLU{}, nil },
-	"sigmoid": func(_ string) (IActivation, error) { return &Sigmoid{}, nil },
-	"tanh":    func(_ string) (IActivation, error) { return &Tanh{}, nil },
+	"relu":     func(_ string) (IActivation, error) { return &ReLU{}, nil },
+	"sigmoid":  func(_ string) (IActivation, error) { return &Sigmoid{}, nil },
+	"tanh":     func(_ string) (IActivation, error) { return &Tanh{}, nil },
+	"softmax":  func(_ string) (IActivation, error) { return &Softmax{}, nil },
+	"identity": func(_ string) (IActivation, error) { return &Identity{}, nil },
+	"linear":   func(_ string) (IActivation, error) { return &Identity{}, nil },
+	"selu":     func(_ string) (IActivation, error) { return &SELU{}, nil },
+	"silu":     func(_ string) (IActivation, error) { return &SiLU{}, nil },
+	"mish":     func(_ string) (IActivation, error) { return &Mish{}, nil },
+	"softplus": func(_ string) (IActivation, error) { return &Softplus{}, nil },
+	"gelu": func(props string) (IActivation, error) {
+		var a GELU
+		err := json.Unmarshal([]byte(props), &a)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating activation function: %v", err)
+		}
+		return &a, nil
+	},
+	"swish": func(props string) (IActivation, error) {
+		var a Swish
+		err := json.Unmarshal([]byte(props), &a)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating activation function: %v", err)
+		}
+		a.initialize()
+		return &a, nil
+	},
+	"leakyrelu": func(props string) (IActivation, error) {
+		var a LeakyReLU
+		err := json.Unmarshal([]byte(props), &a)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating activation function: %v", err)
+		}
+		a.initialize()
+		return &a, nil
+	},
+	"elu": func(props string) (IActivation, error) {
+		var a ELU
+		err := json.Unmarshal([]byte(props), &a)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating activation function: %v", err)
+		}
+		a.initialize()
+		return &a, nil
+	},
+	"prelu": func(props string) (IActivation, error) {
+		// Alpha is learned and may be zero, so the default only applies when it is missing
+		a := PReLU{Alpha: defaultPReLUAlpha}
+		err := json.Unmarshal([]byte(props), &a)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating activation function: %v", err)
+		}
+		return &a, nil
+	},
 }
 
 // Load creates an activation function instance from a serialized profile attribute.
@@ -24,8 +77,36 @@ var registry = map[string]func(string) (IActivation, error){
 // Returns an error if the activation type is not supported or if deserialization fails.
 
 func Load(attr *model.Attr) (IActivation, error) {
-	if gen, ok := registry[strings.ToLower(attr.Name)]; ok {
+	registryMu.RLock()
+	gen, ok := registry[strings.ToLower(attr.Name)]
+	registryMu.RUnlock()
+	if ok {
 		return gen(attr.Props)
 	}
 	return nil, fmt.Errorf("unsupported activation: %s", attr.Name)
 }
+
+// registryMu guards registry, which Register may change while profiles are loaded.
+var registryMu sync.RWMutex
+
+// Register adds an activation to the registry under the given name, so profiles
+// referring to it can be restored with Load. The name must match what the activation's
+// CallMe returns, and is case-insensitive. gen receives the JSON-encoded properties
+// stored in the profile.
+//
+// It is safe for concurrent use. Registering a name that is already taken, including
+// the name of a built-in activation, returns an error.
+func Register(name string, gen func(props string) (IActivation, error)) error {
+	if name == "" || gen == nil {
+		return fmt.Errorf("got error while registering activation: name and constructor are required")
+	}
+
+	key := strings.ToLower(name)
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[key]; ok {
+		return fmt.Errorf("got error while registering activation: %s is already registered", name)
+	}
+	registry[key] = gen
+	return nil
+}
@@ -60,3 +60,296 @@ func TestLoadTanh(t *testing.T) {
 		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
 	}
 }
+
+func TestLoadSoftmax(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "softmax",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "Softmax"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadSELU(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "selu",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "SELU"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadLeakyReLU(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "leakyrelu",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "LeakyReLU"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadELU(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "elu",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "ELU"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadPReLU(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "prelu",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "PReLU"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadPReLUKeepsZeroAlpha(t *testing.T) {
+	for props, expected := range map[string]float64{`{"Alpha":0}`: 0, "{}": 0.25} {
+		activation, err := Load(&model.Attr{Name: "prelu", Props: props})
+		if err != nil {
+			t.Fatalf("Error test activation generator : %v", err)
+		}
+
+		got := activation.(*PReLU).Alpha
+		if got != expected {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func TestLoadLeakyReLUWithProps(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "leakyrelu",
+		Props: `{"Alpha":0.2}`,
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Fatalf("Error test activation generator : %v", err)
+	}
+
+	expected := 0.2
+	got := activation.(*LeakyReLU).Alpha
+	if got != expected {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
+
+func TestLoadGELU(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "gelu",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "GELU"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadSwish(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "swish",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "Swish"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadSiLU(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "silu",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "SiLU"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadMish(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "mish",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "Mish"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadSoftplus(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "softplus",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "Softplus"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadGELUWithProps(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "gelu",
+		Props: `{"Approximate":true}`,
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Fatalf("Error test activation generator : %v", err)
+	}
+
+	if !activation.(*GELU).Approximate {
+		t.Errorf("Expected approximate GELU to be restored")
+	}
+}
+
+func TestLoadIdentity(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "identity",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "Identity"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadLinear(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "linear",
+		Props: "{}",
+	}
+
+	activation, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test activation generator : %v", err)
+	}
+
+	expectedType := "Identity"
+	resultType := reflect.TypeOf(activation).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test activation generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+type doubler struct{}
+
+func (d *doubler) Activate(val float64) float64          { return 2 * val }
+func (d *doubler) Derivate(_ float64, _ float64) float64 { return 2 }
+func (d *doubler) CallMe() string                        { return "doubler" }
+
+func TestRegister(t *testing.T) {
+	gen := func(_ string) (IActivation, error) { return &doubler{}, nil }
+	if err := Register("doubler", gen); err != nil {
+		t.Fatalf("Error test activation register : %v", err)
+	}
+	t.Cleanup(func() { delete(registry, "doubler") })
+
+	activation, err := Load(&model.Attr{Name: "doubler", Props: "{}"})
+	if err != nil {
+		t.Fatalf("Error test activation generator : %v", err)
+	}
+	if _, ok := activation.(*doubler); !ok {
+		t.Errorf("Error test activation generator : Expected doubler, got %T", activation)
+	}
+
+	if err := Register("Doubler", gen); err == nil {
+		t.Errorf("Expected error registering a duplicate name")
+	}
+	if err := Register("relu", gen); err == nil {
+		t.Errorf("Expected error registering a built-in name")
+	}
+}
@@ -0,0 +1,49 @@
+package activation
+
+import (
+	"math"
+)
+
+// GELU (Gaussian Error Linear Unit) weighs its input by the probability that
+// a standard normal variable is below it: x * Φ(x). It is the activation
+// used by most transformer models.
+//
+// Fields:
+//   - Approximate: Use the tanh approximation
+//     0.5 * x * (1 + tanh(sqrt(2/π) * (x + 0.044715 * x³))) instead of the exact erf form.
+type GELU struct {
+	Approximate bool
+}
+
+func NewGELU(approximate bool) *GELU {
+	return &GELU{
+		Approximate: approximate,
+	}
+}
+
+const geluCoef = 0.044715
+
+func (s *GELU) Activate(val float64) (result float64) {
+	if s.Approximate {
+		return 0.5 * val * (1 + math.Tanh(math.Sqrt(2/math.Pi)*(val+geluCoef*val*val*val)))
+	}
+	return val * normalCDF(val)
+}
+
+// The exact derivative is Φ(x) + x * φ(x), where φ is the standard normal density.
+func (s *GELU) Derivate(val float64, _ float64) (result float64) {
+	if s.Approximate {
+		k := math.Sqrt(2 / math.Pi)
+		t := math.Tanh(k * (val + geluCoef*val*val*val))
+		return 0.5*(1+t) + 0.5*val*(1-t*t)*k*(1+3*geluCoef*val*val)
+	}
+	return normalCDF(val) + val*math.Exp(-0.5*val*val)/math.Sqrt(2*math.Pi)
+}
+
+func (l *GELU) CallMe() string {
+	return "gelu"
+}
+
+func normalCDF(x float64) float64 {
+	return 0.5 * (1 + math.Erf(x/math.Sqrt2))
+}
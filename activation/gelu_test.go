@@ -0,0 +1,70 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+func getGELUExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-1.5: -0.100211,
+		-0.3: -0.114627,
+		0.5:  0.345731,
+		2:    1.954500,
+	}
+}
+
+func TestActivateGELU(t *testing.T) {
+	activation := NewGELU(false)
+	vals := getGELUExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func TestDerivateGELU(t *testing.T) {
+	activation := NewGELU(false)
+	const h = 1e-6
+	for val := range getGELUExpectedValues() {
+		expected := (activation.Activate(val+h) - activation.Activate(val-h)) / (2 * h)
+		got := activation.Derivate(val, activation.Activate(val))
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func getGELUApproximateExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-1.5: -0.100428,
+		-0.3: -0.114629,
+		0.5:  0.345714,
+		2:    1.954598,
+	}
+}
+
+func TestActivateGELUApproximate(t *testing.T) {
+	activation := NewGELU(true)
+	vals := getGELUApproximateExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func TestDerivateGELUApproximate(t *testing.T) {
+	activation := NewGELU(true)
+	const h = 1e-6
+	for val := range getGELUApproximateExpectedValues() {
+		expected := (activation.Activate(val+h) - activation.Activate(val-h)) / (2 * h)
+		got := activation.Derivate(val, activation.Activate(val))
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
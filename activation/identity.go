@@ -0,0 +1,23 @@
+package activation
+
+// Identity (also known as Linear) is an activation function that passes its
+// input through unchanged. It is meant for the output layer of regression
+// networks, where targets are unbounded and must not be squashed or clipped.
+// Its derivative is always 1.
+type Identity struct{}
+
+func NewIdentity() *Identity {
+	return &Identity{}
+}
+
+func (s *Identity) Activate(val float64) (result float64) {
+	return val
+}
+
+func (s *Identity) Derivate(_ float64, _ float64) (result float64) {
+	return 1
+}
+
+func (l *Identity) CallMe() string {
+	return "identity"
+}
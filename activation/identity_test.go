@@ -0,0 +1,26 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+func getIdentityExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-12.5: -12.5,
+		0.02:  0.02,
+		-0.34: -0.34,
+		250:   250,
+	}
+}
+
+func TestActivateIdentity(t *testing.T) {
+	activation := Identity{}
+	vals := getIdentityExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
@@ -1,7 +1,32 @@
 package activation
 
+// IActivation is an element-wise activation function applied to the weighted sum z of every
+// neuron. Derivate returns the derivative of Activate with respect to z. It receives both z and
+// the activated output a = Activate(z), so implementations can use whichever is cheaper.
 type IActivation interface {
 	Activate(val float64) (result float64)
-	Derivate(val float64) (result float64)
+	Derivate(z float64, a float64) (result float64)
 	CallMe() string
-}
\ No newline at end of file
+}
+
+// IVectorActivation is implemented by activations whose outputs depend on the whole layer,
+// such as Softmax. The network calls ActivateVector with the weighted sums of all neurons of
+// the layer instead of calling Activate per neuron, and BackwardVector to turn the gradient
+// with respect to the layer outputs into the gradient with respect to the weighted sums.
+// BackwardVector receives the weighted sums and the outputs of the layer.
+type IVectorActivation interface {
+	IActivation
+	ActivateVector(vals []float64) (result []float64)
+	BackwardVector(sums []float64, outputs []float64, grad []float64) (result []float64)
+}
+
+// ILearnable is implemented by activations with trainable parameters, such as PReLU.
+// The network updates Params with the optimizer alongside the layer's weights.
+// ParamGradient receives the weighted sums and outputs of the layer and the gradient of the
+// loss with respect to the outputs, and returns the gradient with respect to each parameter.
+type ILearnable interface {
+	IActivation
+	Params() (params []float64)
+	SetParams(params []float64)
+	ParamGradient(sums []float64, outputs []float64, grad []float64) (result []float64)
+}
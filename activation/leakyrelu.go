@@ -0,0 +1,44 @@
+package activation
+
+// LeakyReLU is a variant of ReLU that lets a small, fixed fraction of
+// negative inputs through instead of zeroing them: it outputs the input
+// when positive and Alpha times the input otherwise. The non-zero slope
+// keeps gradients flowing for negative inputs, which avoids "dead" neurons.
+//
+// Fields:
+//   - Alpha: Slope for negative inputs. Defaults to 0.01 when zero.
+type LeakyReLU struct {
+	Alpha float64
+}
+
+func NewLeakyReLU(alpha float64) *LeakyReLU {
+	s := &LeakyReLU{
+		Alpha: alpha,
+	}
+	s.initialize()
+	return s
+}
+
+func (s *LeakyReLU) Activate(val float64) (result float64) {
+	if val >= zero {
+		return val
+	}
+	return s.Alpha * val
+}
+
+func (s *LeakyReLU) Derivate(z float64, _ float64) (result float64) {
+	if z >= zero {
+		return 1
+	}
+	return s.Alpha
+}
+
+func (s *LeakyReLU) initialize() {
+	if s.Alpha == 0 {
+		s.Alpha = float64(0.01)
+	}
+}
+
+func (l *LeakyReLU) CallMe() string {
+	return "leakyrelu"
+}
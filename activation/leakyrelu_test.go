@@ -0,0 +1,34 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+func getLeakyReLUExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-0.01: -0.001,
+		0.02:  0.02,
+		-0.34: -0.034,
+		0.25:  0.25,
+	}
+}
+
+func TestActivateLeakyReLU(t *testing.T) {
+	activation := NewLeakyReLU(0.1)
+	vals := getLeakyReLUExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func TestLeakyReLUDefaultAlpha(t *testing.T) {
+	activation := NewLeakyReLU(0)
+	expected := 0.01
+	if activation.Alpha != expected {
+		t.Errorf("Expected %f, get %f", expected, activation.Alpha)
+	}
+}
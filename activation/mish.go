@@ -0,0 +1,27 @@
+package activation
+
+import (
+	"math"
+)
+
+// Mish is a smooth, non-monotonic activation defined as x * tanh(softplus(x)).
+// Like Swish it keeps a small negative region and is unbounded above.
+type Mish struct{}
+
+func NewMish() *Mish {
+	return &Mish{}
+}
+
+func (s *Mish) Activate(val float64) (result float64) {
+	return val * math.Tanh(softplus(val))
+}
+
+// The derivative is tanh(softplus(x)) + x * sech²(softplus(x)) * sigmoid(x).
+func (s *Mish) Derivate(val float64, _ float64) (result float64) {
+	t := math.Tanh(softplus(val))
+	return t + val*(1-t*t)*sigmoid(val)
+}
+
+func (l *Mish) CallMe() string {
+	return "mish"
+}
@@ -0,0 +1,38 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+func getMishExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-1.5: -0.298100,
+		-0.3: -0.151133,
+		0.5:  0.375245,
+		2:    1.943959,
+	}
+}
+
+func TestActivateMish(t *testing.T) {
+	activation := NewMish()
+	vals := getMishExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func TestDerivateMish(t *testing.T) {
+	activation := NewMish()
+	const h = 1e-6
+	for val := range getMishExpectedValues() {
+		expected := (activation.Activate(val+h) - activation.Activate(val-h)) / (2 * h)
+		got := activation.Derivate(val, activation.Activate(val))
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package activation
+
+// PReLU (Parametric ReLU) is a LeakyReLU whose negative slope is learned
+// during training instead of being fixed. The network treats Alpha as a
+// trainable parameter of the layer, shared by all its neurons, and updates
+// it with the optimizer alongside the weights.
+//
+// Fields:
+//   - Alpha: Learned slope for negative inputs. Starts at 0.25 with NewPReLU. Zero is a valid
+//     learned slope, so it isn't replaced by the default.
+type PReLU struct {
+	Alpha float64
+}
+
+// defaultPReLUAlpha is the slope a new PReLU starts training from.
+const defaultPReLUAlpha = float64(0.25)
+
+func NewPReLU() *PReLU {
+	return &PReLU{
+		Alpha: defaultPReLUAlpha,
+	}
+}
+
+func (s *PReLU) Activate(val float64) (result float64) {
+	if val >= zero {
+		return val
+	}
+	return s.Alpha * val
+}
+
+func (s *PReLU) Derivate(z float64, _ float64) (result float64) {
+	if z >= zero {
+		return 1
+	}
+	return s.Alpha
+}
+
+func (s *PReLU) Params() []float64 {
+	return []float64{s.Alpha}
+}
+
+func (s *PReLU) SetParams(params []float64) {
+	s.Alpha = params[0]
+}
+
+// ParamGradient returns the gradient with respect to Alpha: the sum of grad times the input
+// over the neurons with negative input.
+func (s *PReLU) ParamGradient(sums []float64, _ []float64, grad []float64) []float64 {
+	var sum float64
+	for i, z := range sums {
+		if z < zero {
+			sum += grad[i] * z
+		}
+	}
+	return []float64{sum}
+}
+
+func (l *PReLU) CallMe() string {
+	return "prelu"
+}
@@ -0,0 +1,40 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+func getPReLUExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-0.01: -0.0025,
+		0.02:  0.02,
+		-0.4:  -0.1,
+		0.25:  0.25,
+	}
+}
+
+func TestActivatePReLU(t *testing.T) {
+	activation := NewPReLU()
+	vals := getPReLUExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func TestParamGradientPReLU(t *testing.T) {
+	activation := NewPReLU()
+	sums := []float64{-0.4, 0.3, -2}
+	outputs := []float64{activation.Activate(-0.4), activation.Activate(0.3), activation.Activate(-2)}
+	grad := []float64{1, 5, 0.5}
+
+	// Only negative inputs depend on Alpha: 1 * -0.4 + 0.5 * -2
+	expected := -1.4
+	got := activation.ParamGradient(sums, outputs, grad)[0]
+	if math.Abs(got-expected) > 0.0001 {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
@@ -21,8 +21,8 @@ func (s *ReLU) Activate(val float64) (result float64) {
 	return math.Max(zero, val)
 }
 
-func (s *ReLU) Derivate(val float64) (result float64) {
-	if val >= zero {
+func (s *ReLU) Derivate(z float64, _ float64) (result float64) {
+	if z > zero {
 		return 1
 	}
 
@@ -0,0 +1,40 @@
+package activation
+
+import (
+	"math"
+)
+
+const (
+	seluAlpha  = 1.6732632423543772
+	seluLambda = 1.0507009873554805
+)
+
+// SELU (Scaled Exponential Linear Unit) is an ELU scaled by fixed constants
+// (lambda ≈ 1.0507, alpha ≈ 1.6733) chosen so that activations of a deep
+// stack of dense layers converge to zero mean and unit variance. It is
+// meant to be used with LeCun normal initialization.
+type SELU struct{}
+
+func NewSELU() *SELU {
+	return &SELU{}
+}
+
+func (s *SELU) Activate(val float64) (result float64) {
+	if val > zero {
+		return seluLambda * val
+	}
+	return seluLambda * seluAlpha * (math.Exp(val) - 1)
+}
+
+// Derivate uses that for negative inputs the derivative lambda * alpha * exp(z)
+// equals a + lambda * alpha.
+func (s *SELU) Derivate(z float64, a float64) (result float64) {
+	if z > zero {
+		return seluLambda
+	}
+	return a + seluLambda*seluAlpha
+}
+
+func (l *SELU) CallMe() string {
+	return "selu"
+}
@@ -0,0 +1,26 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+func getSELUExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-0.5: -0.691758,
+		0.02: 0.021014,
+		-2:   -1.520166,
+		0.25: 0.262675,
+	}
+}
+
+func TestActivateSELU(t *testing.T) {
+	activation := SELU{}
+	vals := getSELUExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
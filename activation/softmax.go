@@ -0,0 +1,71 @@
+package activation
+
+import (
+	"math"
+)
+
+// Softmax is a vector activation that turns the weighted sums of a layer
+// into a probability distribution: every output is exp(x_i) / sum(exp(x_j)),
+// so the outputs lie in (0, 1) and sum to 1. It is used on the output layer
+// of multi-class classifiers, usually together with categorical cross-entropy,
+// in which case the output gradient simplifies to predicted - target.
+//
+// Softmax implements IVectorActivation. Its scalar Activate treats the value
+// as a layer of a single neuron, and Derivate returns the diagonal of the
+// Jacobian, a * (1 - a).
+type Softmax struct{}
+
+func NewSoftmax() *Softmax {
+	return &Softmax{}
+}
+
+func (s *Softmax) Activate(_ float64) (result float64) {
+	return 1
+}
+
+func (s *Softmax) Derivate(_ float64, a float64) (result float64) {
+	return a * (1 - a)
+}
+
+func (s *Softmax) ActivateVector(vals []float64) (result []float64) {
+	if len(vals) == 0 {
+		return nil
+	}
+
+	// Shift by the maximum to keep exp from overflowing
+	highest := vals[0]
+	for _, val := range vals {
+		highest = math.Max(highest, val)
+	}
+
+	var sum float64
+	result = make([]float64, len(vals))
+	for i, val := range vals {
+		result[i] = math.Exp(val - highest)
+		sum += result[i]
+	}
+
+	for i := range result {
+		result[i] /= sum
+	}
+
+	return result
+}
+
+func (s *Softmax) BackwardVector(_ []float64, outputs []float64, grad []float64) (result []float64) {
+	var dot float64
+	for i := range outputs {
+		dot += grad[i] * outputs[i]
+	}
+
+	result = make([]float64, len(outputs))
+	for i := range outputs {
+		result[i] = outputs[i] * (grad[i] - dot)
+	}
+
+	return result
+}
+
+func (l *Softmax) CallMe() string {
+	return "softmax"
+}
@@ -0,0 +1,38 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+func getSoftmaxExpectedValues() (input []float64, expected []float64) {
+	return []float64{1, 2, 3}, []float64{0.090031, 0.244728, 0.665241}
+}
+
+func TestActivateSoftmax(t *testing.T) {
+	activation := Softmax{}
+	input, expected := getSoftmaxExpectedValues()
+	got := activation.ActivateVector(input)
+
+	var sum float64
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+		sum += got[i]
+	}
+
+	if math.Abs(sum-1) > 0.0001 {
+		t.Errorf("Expected probabilities to sum to 1, get %f", sum)
+	}
+}
+
+func TestActivateSoftmaxLargeInput(t *testing.T) {
+	activation := Softmax{}
+	got := activation.ActivateVector([]float64{1000, 1000})
+	for _, val := range got {
+		if math.Abs(val-0.5) > 0.0001 {
+			t.Errorf("Expected %f, get %f", 0.5, val)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package activation
+
+import (
+	"math"
+)
+
+// Softplus is a smooth approximation of ReLU defined as log(1 + exp(x)).
+// Its output is always positive and its derivative is sigmoid(x), so the
+// gradient never becomes exactly zero.
+type Softplus struct{}
+
+func NewSoftplus() *Softplus {
+	return &Softplus{}
+}
+
+func (s *Softplus) Activate(val float64) (result float64) {
+	return softplus(val)
+}
+
+func (s *Softplus) Derivate(val float64, _ float64) (result float64) {
+	return sigmoid(val)
+}
+
+func (l *Softplus) CallMe() string {
+	return "softplus"
+}
+
+// softplus computes log(1 + exp(x)) without overflowing for large x.
+func softplus(x float64) float64 {
+	return math.Max(x, 0) + math.Log1p(math.Exp(-math.Abs(x)))
+}
+
+func sigmoid(x float64) float64 {
+	return 1 / (1 + math.Exp(-x))
+}
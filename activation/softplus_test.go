@@ -0,0 +1,38 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+func getSoftplusExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-1.5: 0.201413,
+		-0.3: 0.554355,
+		0.5:  0.974077,
+		2:    2.126928,
+	}
+}
+
+func TestActivateSoftplus(t *testing.T) {
+	activation := NewSoftplus()
+	vals := getSoftplusExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func TestDerivateSoftplus(t *testing.T) {
+	activation := NewSoftplus()
+	const h = 1e-6
+	for val := range getSoftplusExpectedValues() {
+		expected := (activation.Activate(val+h) - activation.Activate(val-h)) / (2 * h)
+		got := activation.Derivate(val, activation.Activate(val))
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package activation
+
+// Swish is a smooth, non-monotonic activation defined as x * sigmoid(Beta * x).
+// It behaves like ReLU for large inputs but lets small negative values through.
+// With Beta = 1 it is the same as SiLU.
+//
+// Fields:
+//   - Beta: Sharpness of the sigmoid gate. Defaults to 1 when zero.
+type Swish struct {
+	Beta float64
+}
+
+func NewSwish(beta float64) *Swish {
+	s := &Swish{
+		Beta: beta,
+	}
+	s.initialize()
+	return s
+}
+
+func (s *Swish) Activate(val float64) (result float64) {
+	return val * sigmoid(s.Beta*val)
+}
+
+// The derivative is sigmoid(Beta * x) + Beta * x * sigmoid(Beta * x) * (1 - sigmoid(Beta * x)).
+func (s *Swish) Derivate(val float64, _ float64) (result float64) {
+	sig := sigmoid(s.Beta * val)
+	return sig + s.Beta*val*sig*(1-sig)
+}
+
+func (s *Swish) initialize() {
+	if s.Beta == 0 {
+		s.Beta = float64(1)
+	}
+}
+
+func (l *Swish) CallMe() string {
+	return "swish"
+}
+
+// SiLU (Sigmoid Linear Unit) is x * sigmoid(x), i.e. Swish with Beta = 1.
+type SiLU struct{}
+
+func NewSiLU() *SiLU {
+	return &SiLU{}
+}
+
+func (s *SiLU) Activate(val float64) (result float64) {
+	return val * sigmoid(val)
+}
+
+func (s *SiLU) Derivate(val float64, _ float64) (result float64) {
+	sig := sigmoid(val)
+	return sig + val*sig*(1-sig)
+}
+
+func (l *SiLU) CallMe() string {
+	return "silu"
+}
@@ -0,0 +1,70 @@
+package activation
+
+import (
+	"math"
+	"testing"
+)
+
+func getSwishExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-1.5: -0.071139,
+		-0.3: -0.106303,
+		0.5:  0.365529,
+		2:    1.964028,
+	}
+}
+
+func TestActivateSwish(t *testing.T) {
+	activation := NewSwish(2)
+	vals := getSwishExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func TestDerivateSwish(t *testing.T) {
+	activation := NewSwish(2)
+	const h = 1e-6
+	for val := range getSwishExpectedValues() {
+		expected := (activation.Activate(val+h) - activation.Activate(val-h)) / (2 * h)
+		got := activation.Derivate(val, activation.Activate(val))
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func getSiLUExpectedValues() map[float64]float64 {
+	return map[float64]float64{
+		-1.5: -0.273638,
+		-0.3: -0.127667,
+		0.5:  0.311230,
+		2:    1.761594,
+	}
+}
+
+func TestActivateSiLU(t *testing.T) {
+	activation := NewSiLU()
+	vals := getSiLUExpectedValues()
+	for val, expected := range vals {
+		got := activation.Activate(val)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
+
+func TestDerivateSiLU(t *testing.T) {
+	activation := NewSiLU()
+	const h = 1e-6
+	for val := range getSiLUExpectedValues() {
+		expected := (activation.Activate(val+h) - activation.Activate(val-h)) / (2 * h)
+		got := activation.Derivate(val, activation.Activate(val))
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
@@ -18,8 +18,8 @@ func (s *Tanh) Activate(val float64) (result float64) {
 	return math.Tanh(val)
 }
 
-func (s *Tanh) Derivate(val float64) (result float64) {
-	return 1 / math.Pow(math.Cosh(val), 2)
+func (s *Tanh) Derivate(_ float64, a float64) (result float64) {
+	return 1 - a*a
 }
 
 func (l *Tanh) CallMe() string {
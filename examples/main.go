@@ -10,30 +10,27 @@ import (
 	"strings"
 
 	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/loss"
 	"github.com/harungurubudi/rolade/network"
 	"github.com/harungurubudi/rolade/optimizer"
 	"github.com/harungurubudi/rolade/preprocessor"
 )
 
 func main() {
-	nt, err := network.NewNetwork(4, 2, &activation.Tanh{})
+	nt, err := network.NewNetwork(4, 3, &activation.Tanh{}, nil)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	err = nt.AddLayer(4, &activation.Sigmoid{})
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	err = nt.AddLayer(2, &activation.Sigmoid{})
+	err = nt.AddLayer(8, &activation.Softmax{}, nil)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	nt.SetProps(network.Props{
+		Loss: &loss.CategoricalCrossEntropy{},
 		Optimizer: &optimizer.SGD{
-			Alpha: 1,
+			Alpha: 0.5,
 		},
 		ErrLimit: 0.002,
 		MaxEpoch: 20000,
@@ -83,12 +80,10 @@ func testModel(model *network.Network) {
 		expected := bindLabel([]int{
 			int(targets[i][0]),
 			int(targets[i][1]),
+			int(targets[i][2]),
 		})
 
-		got := bindLabel([]int{
-			res[0],
-			res[1],
-		})
+		got := bindLabel(res)
 
 		log.Printf("%d. Expected %s, but got %s", (i + 1), expected, got)
 
@@ -104,11 +99,11 @@ func testModel(model *network.Network) {
 }
 
 func bindLabel(target []int) string {
-	if compareLabel(target, []int{0, 0}) {
+	if compareLabel(target, []int{1, 0, 0}) {
 		return "Iris-setosa"
-	} else if compareLabel(target, []int{0, 1}) {
+	} else if compareLabel(target, []int{0, 1, 0}) {
 		return "Iris-versicolor"
-	} else if compareLabel(target, []int{1, 0}) {
+	} else if compareLabel(target, []int{0, 0, 1}) {
 		return "Iris-virginica"
 	} else {
 		return "Undefined"
@@ -116,7 +111,7 @@ func bindLabel(target []int) string {
 }
 
 func compareLabel(input, target []int) (result bool) {
-	if input[0] == target[0] && input[1] == target[1] {
+	if input[0] == target[0] && input[1] == target[1] && input[2] == target[2] {
 		result = true
 	}
 
@@ -152,11 +147,11 @@ func getData(filepath string) (features []network.Vector, targets []network.Vect
 
 		switch item[4] {
 		case "Iris-setosa":
-			targets = append(targets, network.Vector{0, 0})
+			targets = append(targets, network.Vector{1, 0, 0})
 		case "Iris-versicolor":
-			targets = append(targets, network.Vector{0, 1})
+			targets = append(targets, network.Vector{0, 1, 0})
 		case "Iris-virginica":
-			targets = append(targets, network.Vector{1, 0})
+			targets = append(targets, network.Vector{0, 0, 1})
 		}
 	}
 
@@ -0,0 +1,52 @@
+package initializer
+
+import (
+	"math/rand"
+)
+
+// Zeros sets every weight and bias to zero. All neurons of a layer then
+// receive the same gradient and never become different, so it is only
+// useful for layers that don't need to break symmetry, or for testing.
+type Zeros struct{}
+
+func (i *Zeros) Weight(fanIn int, fanOut int, _ *rand.Rand) (result [][]float64) {
+	return matrix(fanIn, fanOut, func() float64 { return 0 })
+}
+
+func (i *Zeros) Bias(fanOut int, _ *rand.Rand) (result []float64) {
+	return make([]float64, fanOut)
+}
+
+func (i *Zeros) CallMe() string {
+	return "zeros"
+}
+
+// Constant sets every weight and bias to Value.
+//
+// Fields:
+//   - Value: The value of every weight and bias.
+type Constant struct {
+	Value float64
+}
+
+func NewConstant(value float64) *Constant {
+	return &Constant{
+		Value: value,
+	}
+}
+
+func (i *Constant) Weight(fanIn int, fanOut int, _ *rand.Rand) (result [][]float64) {
+	return matrix(fanIn, fanOut, func() float64 { return i.Value })
+}
+
+func (i *Constant) Bias(fanOut int, _ *rand.Rand) (result []float64) {
+	result = make([]float64, fanOut)
+	for j := range result {
+		result[j] = i.Value
+	}
+	return result
+}
+
+func (i *Constant) CallMe() string {
+	return "constant"
+}
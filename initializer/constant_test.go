@@ -0,0 +1,28 @@
+package initializer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInitializeZeros(t *testing.T) {
+	i := Zeros{}
+	mean, stddev := getStats(t, i.Weight(3, 4, newTestRand()), 3, 4)
+	if mean != 0 || stddev != 0 {
+		t.Errorf("Expected only zeros, get mean %f and stddev %f", mean, stddev)
+	}
+}
+
+func TestInitializeConstant(t *testing.T) {
+	i := NewConstant(0.1)
+	mean, stddev := getStats(t, i.Weight(3, 4, newTestRand()), 3, 4)
+	if math.Abs(mean-0.1) > 1e-12 || stddev > 1e-12 {
+		t.Errorf("Expected only %f, get mean %f and stddev %f", 0.1, mean, stddev)
+	}
+
+	for _, val := range i.Bias(4, newTestRand()) {
+		if val != 0.1 {
+			t.Errorf("Expected %f, get %f", 0.1, val)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package initializer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+
+	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/model"
+)
+
+// registry maps initializer names to their constructor functions. Each constructor takes a
+// serialized JSON string of initializer-specific properties and returns an IInitializer
+// implementation. It is used to reconstruct initializers from profile metadata.
+var registry = map[string]func(string) (IInitializer, error){
+	"xavier_uniform": func(_ string) (IInitializer, error) { return &XavierUniform{}, nil },
+	"xavier_normal":  func(_ string) (IInitializer, error) { return &XavierNormal{}, nil },
+	"he_uniform":     func(_ string) (IInitializer, error) { return &HeUniform{}, nil },
+	"he_normal":      func(_ string) (IInitializer, error) { return &HeNormal{}, nil },
+	"lecun_uniform":  func(_ string) (IInitializer, error) { return &LeCunUniform{}, nil },
+	"lecun_normal":   func(_ string) (IInitializer, error) { return &LeCunNormal{}, nil },
+	"zeros":          func(_ string) (IInitializer, error) { return &Zeros{}, nil },
+	"constant": func(props string) (IInitializer, error) {
+		var i Constant
+		err := json.Unmarshal([]byte(props), &i)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating initializer: %v", err)
+		}
+		return &i, nil
+	},
+	"orthogonal": func(props string) (IInitializer, error) {
+		var i Orthogonal
+		err := json.Unmarshal([]byte(props), &i)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating initializer: %v", err)
+		}
+		i.initialize()
+		return &i, nil
+	},
+	"uniform": func(props string) (IInitializer, error) {
+		var i Uniform
+		err := json.Unmarshal([]byte(props), &i)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating initializer: %v", err)
+		}
+		i.initialize()
+		return &i, nil
+	},
+}
+
+// Load creates an initializer instance from a serialized profile attribute. It looks up the
+// initializer by name and initializes it using the provided Props field, which may contain
+// JSON-encoded parameters required by some initializers.
+//
+// Returns an error if the initializer is not supported or if deserialization fails.
+func Load(attr *model.Attr) (IInitializer, error) {
+	registryMu.RLock()
+	gen, ok := registry[strings.ToLower(attr.Name)]
+	registryMu.RUnlock()
+	if ok {
+		return gen(attr.Props)
+	}
+	return nil, fmt.Errorf("unsupported initializer: %s", attr.Name)
+}
+
+// registryMu guards registry, which Register may change while profiles are loaded.
+var registryMu sync.RWMutex
+
+// Register adds an initializer to the registry under the given name, so profiles referring
+// to it can be restored with Load. The name must match what the initializer's CallMe returns,
+// and is case-insensitive. gen receives the JSON-encoded properties stored in the profile.
+//
+// It is safe for concurrent use. Registering a name that is already taken, including the name
+// of a built-in initializer, returns an error.
+func Register(name string, gen func(props string) (IInitializer, error)) error {
+	if name == "" || gen == nil {
+		return fmt.Errorf("got error while registering initializer: name and constructor are required")
+	}
+
+	key := strings.ToLower(name)
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[key]; ok {
+		return fmt.Errorf("got error while registering initializer: %s is already registered", name)
+	}
+	registry[key] = gen
+	return nil
+}
+
+// Default returns the initializer that suits the given activation: He normal for the
+// ReLU family and other unbounded, ReLU-like activations, LeCun normal for SELU, which
+// needs it to stay self-normalizing, and Xavier uniform for everything else, such as
+// Sigmoid, Tanh, Softmax and Identity.
+func Default(act activation.IActivation) IInitializer {
+	switch act.(type) {
+	case *activation.ReLU, *activation.LeakyReLU, *activation.PReLU, *activation.ELU,
+		*activation.GELU, *activation.Swish, *activation.SiLU, *activation.Mish:
+		return &HeNormal{}
+	case *activation.SELU:
+		return &LeCunNormal{}
+	default:
+		return &XavierUniform{}
+	}
+}
+
+// matrix returns a rows x cols matrix filled by calling fn for every value.
+func matrix(rows int, cols int, fn func() float64) [][]float64 {
+	result := make([][]float64, rows)
+	for i := range result {
+		result[i] = make([]float64, cols)
+		for j := range result[i] {
+			result[i][j] = fn()
+		}
+	}
+	return result
+}
+
+// uniform returns a function drawing values uniformly from [-limit, limit].
+func uniform(limit float64, rng *rand.Rand) func() float64 {
+	return func() float64 {
+		return (rng.Float64()*2 - 1) * limit
+	}
+}
+
+// normal returns a function drawing values from a normal distribution with mean 0
+// and the given standard deviation.
+func normal(stddev float64, rng *rand.Rand) func() float64 {
+	return func() float64 {
+		return rng.NormFloat64() * stddev
+	}
+}
@@ -0,0 +1,109 @@
+package initializer
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/model"
+)
+
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewSource(1))
+}
+
+// getStats returns the mean and standard deviation of all values of m, checking its shape.
+func getStats(t *testing.T, m [][]float64, rows int, cols int) (mean float64, stddev float64) {
+	t.Helper()
+	if len(m) != rows {
+		t.Fatalf("Expected %d rows, get %d", rows, len(m))
+	}
+
+	var n float64
+	for _, row := range m {
+		if len(row) != cols {
+			t.Fatalf("Expected %d columns, get %d", cols, len(row))
+		}
+		for _, val := range row {
+			mean += val
+			n++
+		}
+	}
+	mean /= n
+
+	for _, row := range m {
+		for _, val := range row {
+			stddev += (val - mean) * (val - mean)
+		}
+	}
+	return mean, math.Sqrt(stddev / n)
+}
+
+func TestDefault(t *testing.T) {
+	cases := map[string]struct {
+		act      activation.IActivation
+		expected string
+	}{
+		"relu":    {&activation.ReLU{}, "HeNormal"},
+		"gelu":    {activation.NewGELU(false), "HeNormal"},
+		"selu":    {&activation.SELU{}, "LeCunNormal"},
+		"tanh":    {&activation.Tanh{}, "XavierUniform"},
+		"sigmoid": {&activation.Sigmoid{}, "XavierUniform"},
+		"softmax": {&activation.Softmax{}, "XavierUniform"},
+	}
+
+	for name, c := range cases {
+		got := reflect.TypeOf(Default(c.act)).Elem().Name()
+		if got != c.expected {
+			t.Errorf("%s: Expected %s, got %s", name, c.expected, got)
+		}
+	}
+}
+
+func TestLoadBuiltins(t *testing.T) {
+	for name := range registry {
+		i, err := Load(&model.Attr{Name: name, Props: "{}"})
+		if err != nil {
+			t.Fatalf("Error test initializer generator %s : %v", name, err)
+		}
+		if i.CallMe() != name {
+			t.Errorf("Expected %s, got %s", name, i.CallMe())
+		}
+	}
+}
+
+func TestLoadUniformWithProps(t *testing.T) {
+	i, err := Load(&model.Attr{Name: "uniform", Props: `{"Min":-0.1,"Max":0.3}`})
+	if err != nil {
+		t.Fatalf("Error test initializer generator : %v", err)
+	}
+
+	u := i.(*Uniform)
+	if u.Min != -0.1 || u.Max != 0.3 {
+		t.Errorf("Expected [-0.1, 0.3], get [%f, %f]", u.Min, u.Max)
+	}
+
+	if _, err := Load(&model.Attr{Name: "unknown", Props: "{}"}); err == nil {
+		t.Errorf("Expected error loading an unknown initializer")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	gen := func(_ string) (IInitializer, error) { return &Zeros{}, nil }
+	if err := Register("custom", gen); err != nil {
+		t.Fatalf("Error test initializer register : %v", err)
+	}
+	t.Cleanup(func() { delete(registry, "custom") })
+
+	if _, err := Load(&model.Attr{Name: "custom", Props: "{}"}); err != nil {
+		t.Fatalf("Error test initializer generator : %v", err)
+	}
+	if err := Register("Custom", gen); err == nil {
+		t.Errorf("Expected error registering a duplicate name")
+	}
+	if err := Register("zeros", gen); err == nil {
+		t.Errorf("Expected error registering a built-in name")
+	}
+}
@@ -0,0 +1,43 @@
+package initializer
+
+import (
+	"math"
+	"math/rand"
+)
+
+// HeUniform (also known as Kaiming uniform) draws weights uniformly from
+// [-limit, limit] with limit = sqrt(6 / fanIn). It compensates for ReLU
+// zeroing half of its inputs, which would otherwise halve the variance of
+// activations at every layer. Biases start at zero.
+type HeUniform struct{}
+
+func (i *HeUniform) Weight(fanIn int, fanOut int, rng *rand.Rand) (result [][]float64) {
+	limit := math.Sqrt(6 / float64(fanIn))
+	return matrix(fanIn, fanOut, uniform(limit, rng))
+}
+
+func (i *HeUniform) Bias(fanOut int, _ *rand.Rand) (result []float64) {
+	return make([]float64, fanOut)
+}
+
+func (i *HeUniform) CallMe() string {
+	return "he_uniform"
+}
+
+// HeNormal (also known as Kaiming normal) draws weights from a normal
+// distribution with mean 0 and standard deviation sqrt(2 / fanIn).
+// Biases start at zero.
+type HeNormal struct{}
+
+func (i *HeNormal) Weight(fanIn int, fanOut int, rng *rand.Rand) (result [][]float64) {
+	stddev := math.Sqrt(2 / float64(fanIn))
+	return matrix(fanIn, fanOut, normal(stddev, rng))
+}
+
+func (i *HeNormal) Bias(fanOut int, _ *rand.Rand) (result []float64) {
+	return make([]float64, fanOut)
+}
+
+func (i *HeNormal) CallMe() string {
+	return "he_normal"
+}
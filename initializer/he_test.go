@@ -0,0 +1,26 @@
+package initializer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInitializeHeUniform(t *testing.T) {
+	i := HeUniform{}
+	_, stddev := getStats(t, i.Weight(200, 150, newTestRand()), 200, 150)
+
+	expected := math.Sqrt(6.0/200) / math.Sqrt(3)
+	if math.Abs(stddev-expected) > 0.05*expected {
+		t.Errorf("Expected %f, get %f", expected, stddev)
+	}
+}
+
+func TestInitializeHeNormal(t *testing.T) {
+	i := HeNormal{}
+	_, stddev := getStats(t, i.Weight(200, 150, newTestRand()), 200, 150)
+
+	expected := math.Sqrt(2.0 / 200)
+	if math.Abs(stddev-expected) > 0.05*expected {
+		t.Errorf("Expected %f, get %f", expected, stddev)
+	}
+}
@@ -0,0 +1,16 @@
+package initializer
+
+import (
+	"math/rand"
+)
+
+// IInitializer draws the initial parameters of a layer. Weight returns a
+// fanIn x fanOut matrix, laid out like the weights of a synaptic layer
+// (one row per source neuron), and Bias returns fanOut biases. Random
+// values are drawn from rng, so a seeded source gives reproducible layers.
+// CallMe names the initializer in saved profiles (see Load and Register).
+type IInitializer interface {
+	Weight(fanIn int, fanOut int, rng *rand.Rand) (result [][]float64)
+	Bias(fanOut int, rng *rand.Rand) (result []float64)
+	CallMe() string
+}
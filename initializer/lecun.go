@@ -0,0 +1,42 @@
+package initializer
+
+import (
+	"math"
+	"math/rand"
+)
+
+// LeCunUniform draws weights uniformly from [-limit, limit] with
+// limit = sqrt(3 / fanIn), giving the weights a variance of 1 / fanIn.
+// Biases start at zero.
+type LeCunUniform struct{}
+
+func (i *LeCunUniform) Weight(fanIn int, fanOut int, rng *rand.Rand) (result [][]float64) {
+	limit := math.Sqrt(3 / float64(fanIn))
+	return matrix(fanIn, fanOut, uniform(limit, rng))
+}
+
+func (i *LeCunUniform) Bias(fanOut int, _ *rand.Rand) (result []float64) {
+	return make([]float64, fanOut)
+}
+
+func (i *LeCunUniform) CallMe() string {
+	return "lecun_uniform"
+}
+
+// LeCunNormal draws weights from a normal distribution with mean 0 and
+// standard deviation sqrt(1 / fanIn). It is the initialization SELU is
+// designed for. Biases start at zero.
+type LeCunNormal struct{}
+
+func (i *LeCunNormal) Weight(fanIn int, fanOut int, rng *rand.Rand) (result [][]float64) {
+	stddev := math.Sqrt(1 / float64(fanIn))
+	return matrix(fanIn, fanOut, normal(stddev, rng))
+}
+
+func (i *LeCunNormal) Bias(fanOut int, _ *rand.Rand) (result []float64) {
+	return make([]float64, fanOut)
+}
+
+func (i *LeCunNormal) CallMe() string {
+	return "lecun_normal"
+}
@@ -0,0 +1,26 @@
+package initializer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInitializeLeCunUniform(t *testing.T) {
+	i := LeCunUniform{}
+	_, stddev := getStats(t, i.Weight(200, 150, newTestRand()), 200, 150)
+
+	expected := math.Sqrt(1.0 / 200)
+	if math.Abs(stddev-expected) > 0.05*expected {
+		t.Errorf("Expected %f, get %f", expected, stddev)
+	}
+}
+
+func TestInitializeLeCunNormal(t *testing.T) {
+	i := LeCunNormal{}
+	_, stddev := getStats(t, i.Weight(200, 150, newTestRand()), 200, 150)
+
+	expected := math.Sqrt(1.0 / 200)
+	if math.Abs(stddev-expected) > 0.05*expected {
+		t.Errorf("Expected %f, get %f", expected, stddev)
+	}
+}
@@ -0,0 +1,85 @@
+package initializer
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Orthogonal draws a random orthogonal weight matrix: a normal random matrix
+// orthonormalized with Gram-Schmidt, then multiplied by Gain. When fanIn is at
+// least fanOut its columns are orthonormal, otherwise its rows are. Orthogonal
+// weights preserve the norm of the signal, which helps very deep networks.
+// Biases start at zero.
+//
+// Fields:
+//   - Gain: Scale applied to the orthogonal matrix. Defaults to 1 when zero.
+type Orthogonal struct {
+	Gain float64
+}
+
+func NewOrthogonal(gain float64) *Orthogonal {
+	i := &Orthogonal{
+		Gain: gain,
+	}
+	i.initialize()
+	return i
+}
+
+func (i *Orthogonal) Weight(fanIn int, fanOut int, rng *rand.Rand) (result [][]float64) {
+	i.initialize()
+
+	// Orthonormalize the shorter side, as vectors along the longer one
+	count, size := fanOut, fanIn
+	if fanIn < fanOut {
+		count, size = fanIn, fanOut
+	}
+
+	vectors := matrix(count, size, normal(1, rng))
+	for v := range vectors {
+		for u := 0; u < v; u++ {
+			dot := product(vectors[v], vectors[u])
+			for k := range vectors[v] {
+				vectors[v][k] -= dot * vectors[u][k]
+			}
+		}
+
+		norm := math.Sqrt(product(vectors[v], vectors[v]))
+		for k := range vectors[v] {
+			vectors[v][k] /= norm
+		}
+	}
+
+	result = make([][]float64, fanIn)
+	for r := range result {
+		result[r] = make([]float64, fanOut)
+		for c := range result[r] {
+			if fanIn < fanOut {
+				result[r][c] = i.Gain * vectors[r][c]
+			} else {
+				result[r][c] = i.Gain * vectors[c][r]
+			}
+		}
+	}
+	return result
+}
+
+func (i *Orthogonal) Bias(fanOut int, _ *rand.Rand) (result []float64) {
+	return make([]float64, fanOut)
+}
+
+func (i *Orthogonal) initialize() {
+	if i.Gain == 0 {
+		i.Gain = float64(1)
+	}
+}
+
+func (i *Orthogonal) CallMe() string {
+	return "orthogonal"
+}
+
+func product(a []float64, b []float64) (result float64) {
+	for k := range a {
+		result += a[k] * b[k]
+	}
+	return result
+}
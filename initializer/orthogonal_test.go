@@ -0,0 +1,40 @@
+package initializer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInitializeOrthogonal(t *testing.T) {
+	// Tall matrices get orthonormal columns, wide ones orthonormal rows
+	for _, shape := range [][2]int{{6, 4}, {4, 6}, {5, 5}} {
+		fanIn, fanOut := shape[0], shape[1]
+		i := NewOrthogonal(2)
+		w := i.Weight(fanIn, fanOut, newTestRand())
+
+		vectors := make([][]float64, fanOut)
+		if fanIn < fanOut {
+			vectors = w
+		} else {
+			for c := range vectors {
+				vectors[c] = make([]float64, fanIn)
+				for r := range w {
+					vectors[c][r] = w[r][c]
+				}
+			}
+		}
+
+		for a := range vectors {
+			for b := range vectors {
+				expected := float64(0)
+				if a == b {
+					expected = 4 // Gain squared
+				}
+				got := product(vectors[a], vectors[b])
+				if math.Abs(got-expected) > 1e-9 {
+					t.Errorf("%dx%d [%d][%d]: Expected %f, get %f", fanIn, fanOut, a, b, expected, got)
+				}
+			}
+		}
+	}
+}
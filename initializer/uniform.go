@@ -0,0 +1,58 @@
+package initializer
+
+import (
+	"math/rand"
+)
+
+// Uniform draws every weight and bias uniformly from [Min, Max]. With the
+// default range of [-0.5, 0.5] it reproduces how rolade initialized layers
+// before initializers were configurable.
+//
+// Fields:
+//   - Min: Lower bound of the range. Defaults to -0.5 when both bounds are zero.
+//   - Max: Upper bound of the range. Defaults to 0.5 when both bounds are zero.
+type Uniform struct {
+	Min float64
+	Max float64
+}
+
+func NewUniform(min float64, max float64) *Uniform {
+	i := &Uniform{
+		Min: min,
+		Max: max,
+	}
+	i.initialize()
+	return i
+}
+
+func (i *Uniform) Weight(fanIn int, fanOut int, rng *rand.Rand) (result [][]float64) {
+	i.initialize()
+	return matrix(fanIn, fanOut, i.draw(rng))
+}
+
+func (i *Uniform) Bias(fanOut int, rng *rand.Rand) (result []float64) {
+	i.initialize()
+	draw := i.draw(rng)
+	result = make([]float64, fanOut)
+	for j := range result {
+		result[j] = draw()
+	}
+	return result
+}
+
+func (i *Uniform) draw(rng *rand.Rand) func() float64 {
+	return func() float64 {
+		return i.Min + rng.Float64()*(i.Max-i.Min)
+	}
+}
+
+func (i *Uniform) initialize() {
+	if i.Min == 0 && i.Max == 0 {
+		i.Min = float64(-0.5)
+		i.Max = float64(0.5)
+	}
+}
+
+func (i *Uniform) CallMe() string {
+	return "uniform"
+}
@@ -0,0 +1,19 @@
+package initializer
+
+import (
+	"testing"
+)
+
+func TestInitializeUniform(t *testing.T) {
+	i := Uniform{}
+	w := i.Weight(20, 30, newTestRand())
+	b := i.Bias(30, newTestRand())
+
+	for _, row := range append(w, b) {
+		for _, val := range row {
+			if val < -0.5 || val > 0.5 {
+				t.Fatalf("Expected values within [-0.5, 0.5], get %f", val)
+			}
+		}
+	}
+}
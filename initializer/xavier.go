@@ -0,0 +1,43 @@
+package initializer
+
+import (
+	"math"
+	"math/rand"
+)
+
+// XavierUniform (also known as Glorot uniform) draws weights uniformly from
+// [-limit, limit] with limit = sqrt(6 / (fanIn + fanOut)). It keeps the
+// variance of activations and gradients roughly constant across layers with
+// symmetric, saturating activations such as Tanh and Sigmoid. Biases start at zero.
+type XavierUniform struct{}
+
+func (i *XavierUniform) Weight(fanIn int, fanOut int, rng *rand.Rand) (result [][]float64) {
+	limit := math.Sqrt(6 / float64(fanIn+fanOut))
+	return matrix(fanIn, fanOut, uniform(limit, rng))
+}
+
+func (i *XavierUniform) Bias(fanOut int, _ *rand.Rand) (result []float64) {
+	return make([]float64, fanOut)
+}
+
+func (i *XavierUniform) CallMe() string {
+	return "xavier_uniform"
+}
+
+// XavierNormal (also known as Glorot normal) draws weights from a normal
+// distribution with mean 0 and standard deviation sqrt(2 / (fanIn + fanOut)).
+// Biases start at zero.
+type XavierNormal struct{}
+
+func (i *XavierNormal) Weight(fanIn int, fanOut int, rng *rand.Rand) (result [][]float64) {
+	stddev := math.Sqrt(2 / float64(fanIn+fanOut))
+	return matrix(fanIn, fanOut, normal(stddev, rng))
+}
+
+func (i *XavierNormal) Bias(fanOut int, _ *rand.Rand) (result []float64) {
+	return make([]float64, fanOut)
+}
+
+func (i *XavierNormal) CallMe() string {
+	return "xavier_normal"
+}
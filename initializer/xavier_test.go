@@ -0,0 +1,46 @@
+package initializer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInitializeXavierUniform(t *testing.T) {
+	i := XavierUniform{}
+	w := i.Weight(300, 100, newTestRand())
+
+	limit := math.Sqrt(6.0 / 400)
+	for _, row := range w {
+		for _, val := range row {
+			if math.Abs(val) > limit {
+				t.Fatalf("Expected values within %f, get %f", limit, val)
+			}
+		}
+	}
+
+	// A uniform distribution on [-limit, limit] has a standard deviation of limit / sqrt(3)
+	_, stddev := getStats(t, w, 300, 100)
+	expected := limit / math.Sqrt(3)
+	if math.Abs(stddev-expected) > 0.05*expected {
+		t.Errorf("Expected %f, get %f", expected, stddev)
+	}
+
+	for _, val := range i.Bias(100, newTestRand()) {
+		if val != 0 {
+			t.Fatalf("Expected %f, get %f", 0.0, val)
+		}
+	}
+}
+
+func TestInitializeXavierNormal(t *testing.T) {
+	i := XavierNormal{}
+	mean, stddev := getStats(t, i.Weight(300, 100, newTestRand()), 300, 100)
+
+	expected := math.Sqrt(2.0 / 400)
+	if math.Abs(mean) > 0.05*expected {
+		t.Errorf("Expected %f, get %f", 0.0, mean)
+	}
+	if math.Abs(stddev-expected) > 0.05*expected {
+		t.Errorf("Expected %f, get %f", expected, stddev)
+	}
+}
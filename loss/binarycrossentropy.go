@@ -0,0 +1,88 @@
+package loss
+
+import (
+	"math"
+
+	"github.com/harungurubudi/rolade/activation"
+)
+
+// BinaryCrossEntropy is the loss of binary (and multi-label) classification.
+// For a predicted probability p and a target y in [0, 1] it is
+// -(PosWeight * y * log(p) + (1 - y) * log(1 - p)), averaged over the outputs
+// of a sample and then over the samples. Paired with a Sigmoid output layer
+// its gradient with respect to the weighted sums simplifies to
+// p * (PosWeight * y + 1 - y) - PosWeight * y, which doesn't vanish when the
+// sigmoid saturates near 0 or 1, so the network uses it directly.
+//
+// Fields:
+//   - PosWeight: Weight of the positive class, useful for imbalanced data. Defaults to 1.
+type BinaryCrossEntropy struct {
+	PosWeight float64
+}
+
+func NewBinaryCrossEntropy(posWeight float64) *BinaryCrossEntropy {
+	l := &BinaryCrossEntropy{
+		PosWeight: posWeight,
+	}
+	l.initialize()
+	return l
+}
+
+func (l *BinaryCrossEntropy) Calculate(predicted [][]float64, target [][]float64, weights [][]float64) (result float64) {
+	posWeight := l.posWeight()
+
+	return meanOfOutputs(predicted, target, weights, func(p float64, y float64) float64 {
+		p = math.Min(math.Max(p, epsilon), 1-epsilon)
+		return -(posWeight*y*math.Log(p) + (1-y)*math.Log(1-p))
+	})
+}
+
+// Gradient returns the gradient with respect to the predicted probabilities. With a Sigmoid
+// output the network uses FusedGradient instead.
+func (l *BinaryCrossEntropy) Gradient(predicted []float64, target []float64) (grad []float64) {
+	posWeight := l.posWeight()
+
+	n := float64(len(target))
+	grad = make([]float64, len(target))
+	for i := range target {
+		p := math.Min(math.Max(predicted[i], epsilon), 1-epsilon)
+		grad[i] = -(posWeight*target[i]/p - (1-target[i])/(1-p)) / n
+	}
+	return grad
+}
+
+func (l *BinaryCrossEntropy) FusedGradient(act activation.IActivation, predicted []float64, target []float64, weights []float64) ([]float64, bool) {
+	if _, ok := act.(*activation.Sigmoid); !ok {
+		return nil, false
+	}
+
+	posWeight := l.posWeight()
+
+	n := float64(len(target))
+	grad := make([]float64, len(target))
+	for i := range target {
+		weighted := posWeight * target[i]
+		grad[i] = (predicted[i]*(weighted+1-target[i]) - weighted) / n
+		if weights != nil {
+			grad[i] *= weights[i]
+		}
+	}
+	return grad, true
+}
+
+// posWeight returns PosWeight, or its default for a zero-valued BinaryCrossEntropy. It doesn't
+// write to the loss, as its methods run concurrently on the network's workers.
+func (l *BinaryCrossEntropy) posWeight() float64 {
+	if l.PosWeight == 0 {
+		return float64(1)
+	}
+	return l.PosWeight
+}
+
+func (l *BinaryCrossEntropy) initialize() {
+	l.PosWeight = l.posWeight()
+}
+
+func (l *BinaryCrossEntropy) CallMe() string {
+	return "binary_crossentropy"
+}
@@ -0,0 +1,79 @@
+package loss
+
+import (
+	"math"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+)
+
+func getExpectedBinaryProbabilities() (predicted [][]float64, targets [][]float64) {
+	return [][]float64{
+		{0.9, 0.2},
+		{0.4, 0.7},
+	}, [][]float64{
+		{1, 0},
+		{1, 1},
+	}
+}
+
+func TestCalculateBinaryCrossEntropy(t *testing.T) {
+	expected := 0.4004
+
+	predicted, targets := getExpectedBinaryProbabilities()
+	loss := BinaryCrossEntropy{}
+	got := loss.Calculate(predicted, targets, nil)
+
+	if math.Abs(got-expected) > 0.0001 {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
+
+func TestFusedGradientBinaryCrossEntropy(t *testing.T) {
+	predicted, targets := getExpectedBinaryProbabilities()
+	loss := BinaryCrossEntropy{PosWeight: 2}
+
+	got, ok := loss.FusedGradient(&activation.Sigmoid{}, predicted[0], targets[0], nil)
+	if !ok {
+		t.Fatalf("Expected fused gradient for sigmoid")
+	}
+
+	expected := []float64{-0.1, 0.1}
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+
+	// The fused form must match the chain rule through the sigmoid
+	grad := loss.Gradient(predicted[0], targets[0])
+	for i, p := range predicted[0] {
+		chained := grad[i] * p * (1 - p)
+		if math.Abs(got[i]-chained) > 0.0001 {
+			t.Errorf("Expected %f, get %f", chained, got[i])
+		}
+	}
+
+	if _, ok := loss.FusedGradient(&activation.Tanh{}, predicted[0], targets[0], nil); ok {
+		t.Errorf("Expected no fused gradient for tanh")
+	}
+}
+
+func TestFusedGradientBinaryCrossEntropyZeroValue(t *testing.T) {
+	predicted, targets := getExpectedBinaryProbabilities()
+	loss := BinaryCrossEntropy{}
+
+	// The default PosWeight of 1 gives p - y
+	got, _ := loss.FusedGradient(&activation.Sigmoid{}, predicted[0], targets[0], nil)
+	expected := []float64{-0.05, 0.1}
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+
+	// Its methods run concurrently during training, so they must not write the default back
+	if loss.PosWeight != 0 {
+		t.Errorf("Expected PosWeight to stay 0, get %f", loss.PosWeight)
+	}
+}
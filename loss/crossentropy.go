@@ -0,0 +1,72 @@
+package loss
+
+import (
+	"math"
+
+	"github.com/harungurubudi/rolade/activation"
+)
+
+// epsilon keeps log away from zero when a predicted probability underflows.
+const epsilon = 1e-12
+
+// CategoricalCrossEntropy is the loss of multi-class classification. For a
+// predicted probability distribution p and a one-hot (or soft) target y it
+// is -sum(y_i * log(p_i)), averaged over the samples. Paired with a Softmax
+// output layer its gradient with respect to the weighted sums simplifies to
+// p - y, which the network uses directly.
+type CategoricalCrossEntropy struct{}
+
+// Calculate normalizes the weighted sum by the total weight of the target classes, so with
+// one-hot targets every sample counts with the weight of its class.
+func (l *CategoricalCrossEntropy) Calculate(predicted [][]float64, target [][]float64, weights [][]float64) (result float64) {
+	sum, _ := sumOfOutputs(predicted, target, weights, func(p float64, y float64) float64 {
+		return -y * math.Log(math.Max(p, epsilon))
+	})
+	total, _ := sumOfOutputs(predicted, target, weights, func(_ float64, y float64) float64 {
+		return y
+	})
+
+	// If there is no target weight, it returns 0 to prevent division by zero
+	if total == 0 {
+		return 0
+	}
+	return sum / total
+}
+
+// Gradient returns -target / predicted, the gradient with respect to the predicted
+// probabilities. With a Softmax output the network uses FusedGradient instead.
+func (l *CategoricalCrossEntropy) Gradient(predicted []float64, target []float64) (grad []float64) {
+	grad = make([]float64, len(target))
+	for i := range target {
+		grad[i] = -target[i] / math.Max(predicted[i], epsilon)
+	}
+	return grad
+}
+
+// FusedGradient returns predicted - target for a Softmax output. With per-output weights w it
+// returns the exact gradient of the weighted loss, sum(w * target) * predicted - w * target.
+func (l *CategoricalCrossEntropy) FusedGradient(act activation.IActivation, predicted []float64, target []float64, weights []float64) ([]float64, bool) {
+	if _, ok := act.(*activation.Softmax); !ok {
+		return nil, false
+	}
+
+	var targetWeight float64
+	weighted := make([]float64, len(target))
+	for i := range target {
+		weighted[i] = target[i]
+		if weights != nil {
+			weighted[i] *= weights[i]
+		}
+		targetWeight += weighted[i]
+	}
+
+	grad := make([]float64, len(target))
+	for i := range target {
+		grad[i] = targetWeight*predicted[i] - weighted[i]
+	}
+	return grad, true
+}
+
+func (l *CategoricalCrossEntropy) CallMe() string {
+	return "categorical_crossentropy"
+}
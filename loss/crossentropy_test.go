@@ -0,0 +1,81 @@
+package loss
+
+import (
+	"math"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+)
+
+func getExpectedProbabilities() (predicted [][]float64, targets [][]float64) {
+	return [][]float64{
+		{0.7, 0.2, 0.1},
+		{0.1, 0.8, 0.1},
+	}, [][]float64{
+		{1, 0, 0},
+		{0, 1, 0},
+	}
+}
+
+func TestCalculateCategoricalCrossEntropy(t *testing.T) {
+	expected := 0.2899
+
+	predicted, targets := getExpectedProbabilities()
+	loss := CategoricalCrossEntropy{}
+	got := loss.Calculate(predicted, targets, nil)
+
+	if math.Abs(got-expected) > 0.0001 {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
+
+func TestFusedGradientCategoricalCrossEntropy(t *testing.T) {
+	predicted, targets := getExpectedProbabilities()
+	loss := CategoricalCrossEntropy{}
+
+	got, ok := loss.FusedGradient(&activation.Softmax{}, predicted[0], targets[0], nil)
+	if !ok {
+		t.Fatalf("Expected fused gradient for softmax")
+	}
+
+	expected := []float64{-0.3, 0.2, 0.1}
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+
+	if _, ok := loss.FusedGradient(&activation.Sigmoid{}, predicted[0], targets[0], nil); ok {
+		t.Errorf("Expected no fused gradient for sigmoid")
+	}
+}
+
+func TestGradientCategoricalCrossEntropy(t *testing.T) {
+	predicted, targets := getExpectedProbabilities()
+	loss := CategoricalCrossEntropy{}
+
+	got := loss.Gradient(predicted[1], targets[1])
+	expected := []float64{0, -1.25, 0}
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+}
+
+func TestFusedGradientCategoricalCrossEntropyWeighted(t *testing.T) {
+	predicted, targets := getExpectedProbabilities()
+	loss := CategoricalCrossEntropy{}
+
+	got, ok := loss.FusedGradient(&activation.Softmax{}, predicted[0], targets[0], []float64{2, 1, 1})
+	if !ok {
+		t.Fatalf("Expected fused gradient for softmax")
+	}
+
+	expected := []float64{-0.6, 0.4, 0.2}
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+}
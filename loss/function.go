@@ -1,8 +1,10 @@
 package loss
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/harungurubudi/rolade/model"
 )
@@ -10,7 +12,29 @@ import (
 // registry maps loss function names to their corresponding constructor functions.
 // Each entry defines how to instantiate a loss function from its serialized configuration.
 var registry = map[string]func(string) (ILoss, error){
-	"rmse": func(_ string) (ILoss, error) { return &RMSE{}, nil },
+	"rmse":                     func(_ string) (ILoss, error) { return &RMSE{}, nil },
+	"categorical_crossentropy": func(_ string) (ILoss, error) { return &CategoricalCrossEntropy{}, nil },
+	"mse":                      func(_ string) (ILoss, error) { return &MSE{}, nil },
+	"mae":                      func(_ string) (ILoss, error) { return &MAE{}, nil },
+	"logcosh":                  func(_ string) (ILoss, error) { return &LogCosh{}, nil },
+	"huber": func(props string) (ILoss, error) {
+		var l Huber
+		err := json.Unmarshal([]byte(props), &l)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating loss function: %v", err)
+		}
+		l.initialize()
+		return &l, nil
+	},
+	"binary_crossentropy": func(props string) (ILoss, error) {
+		var l BinaryCrossEntropy
+		err := json.Unmarshal([]byte(props), &l)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating loss function: %v", err)
+		}
+		l.initialize()
+		return &l, nil
+	},
 }
 
 // Load returns an ILoss implementation based on the given profile attribute.
@@ -19,8 +43,36 @@ var registry = map[string]func(string) (ILoss, error){
 //
 // This is typically used when restoring a model from a saved profile.
 func Load(attr *model.Attr) (ILoss, error) {
-	if gen, ok := registry[strings.ToLower(attr.Name)]; ok {
+	registryMu.RLock()
+	gen, ok := registry[strings.ToLower(attr.Name)]
+	registryMu.RUnlock()
+	if ok {
 		return gen(attr.Props)
 	}
 	return nil, fmt.Errorf("unsupported loss function: %s", attr.Name)
 }
+
+// registryMu guards registry, which Register may change while profiles are loaded.
+var registryMu sync.RWMutex
+
+// Register adds a loss function to the registry under the given name, so profiles
+// referring to it can be restored with Load. The name must match what the loss function's
+// CallMe returns, and is case-insensitive. gen receives the JSON-encoded properties
+// stored in the profile.
+//
+// It is safe for concurrent use. Registering a name that is already taken, including
+// the name of a built-in loss function, returns an error.
+func Register(name string, gen func(props string) (ILoss, error)) error {
+	if name == "" || gen == nil {
+		return fmt.Errorf("got error while registering loss function: name and constructor are required")
+	}
+
+	key := strings.ToLower(name)
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[key]; ok {
+		return fmt.Errorf("got error while registering loss function: %s is already registered", name)
+	}
+	registry[key] = gen
+	return nil
+}
@@ -24,3 +24,158 @@ func TestLoadRMSE(t *testing.T) {
 		t.Errorf("Error test loss generator : Expected %s, got %s", expectedType, resultType)
 	}
 }
+
+func TestLoadCategoricalCrossEntropy(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "categorical_crossentropy",
+		Props: "{}",
+	}
+
+	loss, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test loss generator : %v", err)
+	}
+
+	expectedType := "CategoricalCrossEntropy"
+	resultType := reflect.TypeOf(loss).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test loss generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadMSE(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "mse",
+		Props: `{}`,
+	}
+
+	loss, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test loss generator : %v", err)
+	}
+
+	expectedType := "MSE"
+	resultType := reflect.TypeOf(loss).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test loss generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadMAE(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "mae",
+		Props: `{}`,
+	}
+
+	loss, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test loss generator : %v", err)
+	}
+
+	expectedType := "MAE"
+	resultType := reflect.TypeOf(loss).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test loss generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadHuber(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "huber",
+		Props: `{"Delta": 0.5}`,
+	}
+
+	loss, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test loss generator : %v", err)
+	}
+
+	expectedType := "Huber"
+	resultType := reflect.TypeOf(loss).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test loss generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadLogCosh(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "logcosh",
+		Props: `{}`,
+	}
+
+	loss, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test loss generator : %v", err)
+	}
+
+	expectedType := "LogCosh"
+	resultType := reflect.TypeOf(loss).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test loss generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadHuberDelta(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "huber",
+		Props: `{"Delta": 0.5}`,
+	}
+
+	loss, err := Load(attr)
+	if err != nil {
+		t.Fatalf("Error test loss generator : %v", err)
+	}
+
+	if delta := loss.(*Huber).Delta; delta != 0.5 {
+		t.Errorf("Error test loss generator : Expected delta 0.5, got %f", delta)
+	}
+}
+
+func TestLoadBinaryCrossEntropy(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "binary_crossentropy",
+		Props: `{"PosWeight": 3}`,
+	}
+
+	loss, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test loss generator : %v", err)
+	}
+
+	expectedType := "BinaryCrossEntropy"
+	resultType := reflect.TypeOf(loss).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test loss generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+type customLoss struct {
+	RMSE
+}
+
+func (l *customLoss) CallMe() string {
+	return "custom_loss"
+}
+
+func TestRegister(t *testing.T) {
+	gen := func(_ string) (ILoss, error) { return &customLoss{}, nil }
+	if err := Register("custom_loss", gen); err != nil {
+		t.Fatalf("Error test loss register : %v", err)
+	}
+	t.Cleanup(func() { delete(registry, "custom_loss") })
+
+	lossFunc, err := Load(&model.Attr{Name: "custom_loss", Props: "{}"})
+	if err != nil {
+		t.Fatalf("Error test loss generator : %v", err)
+	}
+	if _, ok := lossFunc.(*customLoss); !ok {
+		t.Errorf("Error test loss generator : Expected customLoss, got %T", lossFunc)
+	}
+
+	if err := Register("CUSTOM_LOSS", gen); err == nil {
+		t.Errorf("Expected error registering a duplicate name")
+	}
+	if err := Register("mse", gen); err == nil {
+		t.Errorf("Expected error registering a built-in name")
+	}
+}
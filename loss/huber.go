@@ -0,0 +1,65 @@
+package loss
+
+import (
+	"math"
+)
+
+// Huber is a loss function that is quadratic for errors smaller than Delta
+// and linear beyond it. It behaves like MSE close to the target and like MAE
+// for outliers, combining a smooth gradient with robustness.
+//
+// Fields:
+//   - Delta: Error magnitude at which the loss switches from quadratic to linear.
+type Huber struct {
+	Delta float64
+}
+
+func NewHuber(delta float64) *Huber {
+	l := &Huber{
+		Delta: delta,
+	}
+	l.initialize()
+	return l
+}
+
+func (l *Huber) Calculate(predicted [][]float64, target [][]float64, weights [][]float64) (result float64) {
+	delta := l.delta()
+
+	return meanOfOutputs(predicted, target, weights, func(p float64, y float64) float64 {
+		abs := math.Abs(p - y)
+		if abs <= delta {
+			return 0.5 * abs * abs
+		}
+		return delta * (abs - 0.5*delta)
+	})
+}
+
+// Gradient returns the error clipped to [-Delta, Delta], divided by the number of outputs.
+func (l *Huber) Gradient(predicted []float64, target []float64) (grad []float64) {
+	delta := l.delta()
+
+	n := float64(len(target))
+	grad = make([]float64, len(target))
+	for i := range target {
+		diff := predicted[i] - target[i]
+		grad[i] = math.Max(-delta, math.Min(delta, diff)) / n
+	}
+	return grad
+}
+
+// delta returns Delta, or its default for a zero-valued Huber. It doesn't write to the loss,
+// as Calculate and Gradient run concurrently on the network's workers.
+func (l *Huber) delta() float64 {
+	if l.Delta == 0 {
+		return float64(1)
+	}
+	return l.Delta
+}
+
+func (l *Huber) initialize() {
+	l.Delta = l.delta()
+}
+
+func (l *Huber) CallMe() string {
+	return "huber"
+}
@@ -0,0 +1,50 @@
+package loss
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateHuber(t *testing.T) {
+	expected := 0.0733
+
+	predicted, targets := getExpectedErrors()
+	loss := Huber{Delta: 0.5}
+	got := loss.Calculate(predicted, targets, nil)
+
+	if math.Abs(got-expected) > 0.0001 {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
+
+func TestGradientHuber(t *testing.T) {
+	expected := []float64{0.25, -0.1}
+
+	loss := Huber{Delta: 0.5}
+	got := loss.Gradient([]float64{2, 0.3}, []float64{0.5, 0.5})
+
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+}
+
+func TestGradientHuberZeroValue(t *testing.T) {
+	// The default Delta of 1 clips the first error only
+	expected := []float64{0.5, -0.1}
+
+	loss := Huber{}
+	got := loss.Gradient([]float64{2, 0.3}, []float64{0.5, 0.5})
+
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+
+	// Gradient runs concurrently during training, so it must not write the default back
+	if loss.Delta != 0 {
+		t.Errorf("Expected Delta to stay 0, get %f", loss.Delta)
+	}
+}
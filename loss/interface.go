@@ -1,6 +1,29 @@
 package loss
 
+import "github.com/harungurubudi/rolade/activation"
+
+// ILoss is a loss function.
+//
+// Calculate returns the loss over a set of samples, given the full predicted and target
+// vectors of every sample, so errors of different outputs never cancel each other out.
+// weights holds a weight per output of every sample and turns the loss into a weighted
+// average; a nil weights slice weighs everything equally.
+// Gradient returns the gradient of the loss of a single sample with respect to each predicted
+// output; the network uses it as the starting point of backpropagation, so the loss decides
+// what the network learns.
 type ILoss interface {
-	Calculate(deltas []float64) (result float64)
+	Calculate(predicted [][]float64, target [][]float64, weights [][]float64) (result float64)
+	Gradient(predicted []float64, target []float64) (grad []float64)
 	CallMe() string
-}
\ No newline at end of file
+}
+
+// IFusedLoss is implemented by losses that have a simplified, numerically stable gradient
+// when paired with a particular output activation, such as cross-entropy after softmax.
+//
+// FusedGradient returns the gradient of the loss with respect to the weighted sums of the
+// output layer, and false if the given activation has no fused form. weights optionally
+// weighs each output (nil weighs them equally); it is part of the call because the fused
+// form of a weighted loss is not always the weighted fused form.
+type IFusedLoss interface {
+	FusedGradient(act activation.IActivation, predicted []float64, target []float64, weights []float64) (grad []float64, ok bool)
+}
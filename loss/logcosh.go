@@ -0,0 +1,37 @@
+package loss
+
+import (
+	"math"
+)
+
+// LogCosh is a loss function that averages log(cosh(error)). It is
+// approximately err^2 / 2 for small errors and |err| - log(2) for large
+// ones, so like Huber it is smooth near the target and robust to outliers,
+// but it is twice differentiable everywhere.
+type LogCosh struct{}
+
+func (l *LogCosh) Calculate(predicted [][]float64, target [][]float64, weights [][]float64) (result float64) {
+	return meanOfOutputs(predicted, target, weights, func(p float64, y float64) float64 {
+		return logCosh(p - y)
+	})
+}
+
+// Gradient returns tanh(predicted - target) / n for a sample with n outputs.
+func (l *LogCosh) Gradient(predicted []float64, target []float64) (grad []float64) {
+	n := float64(len(target))
+	grad = make([]float64, len(target))
+	for i := range target {
+		grad[i] = math.Tanh(predicted[i]-target[i]) / n
+	}
+	return grad
+}
+
+func (l *LogCosh) CallMe() string {
+	return "logcosh"
+}
+
+// logCosh computes log(cosh(x)) without overflowing for large |x|.
+func logCosh(x float64) float64 {
+	abs := math.Abs(x)
+	return abs + math.Log1p(math.Exp(-2*abs)) - math.Ln2
+}
@@ -0,0 +1,31 @@
+package loss
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateLogCosh(t *testing.T) {
+	expected := 0.0741
+
+	predicted, targets := getExpectedErrors()
+	loss := LogCosh{}
+	got := loss.Calculate(predicted, targets, nil)
+
+	if math.Abs(got-expected) > 0.0001 {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
+
+func TestGradientLogCosh(t *testing.T) {
+	expected := []float64{0.049834, -0.098688}
+
+	loss := LogCosh{}
+	got := loss.Gradient([]float64{0.6, 0.3}, []float64{0.5, 0.5})
+
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package loss
+
+import (
+	"math"
+)
+
+// MAE (Mean Absolute Error) is a loss function that measures the average of
+// the absolute differences between predicted values and actual targets.
+// Every error contributes linearly, which makes it robust to outliers, but
+// its gradient has the same magnitude no matter how close the prediction is.
+type MAE struct{}
+
+func (l *MAE) Calculate(predicted [][]float64, target [][]float64, weights [][]float64) (result float64) {
+	return meanOfOutputs(predicted, target, weights, func(p float64, y float64) float64 {
+		return math.Abs(p - y)
+	})
+}
+
+// Gradient returns sign(predicted - target) / n for a sample with n outputs.
+func (l *MAE) Gradient(predicted []float64, target []float64) (grad []float64) {
+	n := float64(len(target))
+	grad = make([]float64, len(target))
+	for i := range target {
+		diff := predicted[i] - target[i]
+		if diff > 0 {
+			grad[i] = 1 / n
+		} else if diff < 0 {
+			grad[i] = -1 / n
+		}
+	}
+	return grad
+}
+
+func (l *MAE) CallMe() string {
+	return "mae"
+}
@@ -0,0 +1,31 @@
+package loss
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateMAE(t *testing.T) {
+	expected := 0.2725
+
+	predicted, targets := getExpectedErrors()
+	loss := MAE{}
+	got := loss.Calculate(predicted, targets, nil)
+
+	if math.Abs(got-expected) > 0.0001 {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
+
+func TestGradientMAE(t *testing.T) {
+	expected := []float64{0.5, -0.5}
+
+	loss := MAE{}
+	got := loss.Gradient([]float64{0.6, 0.3}, []float64{0.5, 0.5})
+
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+}
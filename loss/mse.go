@@ -0,0 +1,28 @@
+package loss
+
+// MSE (Mean Squared Error) is a loss function that measures the average of
+// the squared differences between predicted values and actual targets. It
+// is the standard loss for regression and penalizes larger errors more than
+// smaller ones. Unlike RMSE it is not square-rooted, so its gradient grows
+// linearly with the error.
+type MSE struct{}
+
+func (l *MSE) Calculate(predicted [][]float64, target [][]float64, weights [][]float64) (result float64) {
+	return meanOfOutputs(predicted, target, weights, func(p float64, y float64) float64 {
+		return (p - y) * (p - y)
+	})
+}
+
+// Gradient returns 2 * (predicted - target) / n for a sample with n outputs.
+func (l *MSE) Gradient(predicted []float64, target []float64) (grad []float64) {
+	n := float64(len(target))
+	grad = make([]float64, len(target))
+	for i := range target {
+		grad[i] = 2 * (predicted[i] - target[i]) / n
+	}
+	return grad
+}
+
+func (l *MSE) CallMe() string {
+	return "mse"
+}
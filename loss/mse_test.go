@@ -0,0 +1,42 @@
+package loss
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateMSE(t *testing.T) {
+	expected := 0.1586
+
+	predicted, targets := getExpectedErrors()
+	loss := MSE{}
+	got := loss.Calculate(predicted, targets, nil)
+
+	if math.Abs(got-expected) > 0.0001 {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
+
+func TestGradientMSE(t *testing.T) {
+	expected := []float64{0.1, -0.2}
+
+	loss := MSE{}
+	got := loss.Gradient([]float64{0.6, 0.3}, []float64{0.5, 0.5})
+
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+}
+
+func TestCalculateMSEWeighted(t *testing.T) {
+	expected := 0.75
+
+	loss := MSE{}
+	got := loss.Calculate([][]float64{{1}, {0}}, [][]float64{{0}, {0}}, [][]float64{{3}, {1}})
+
+	if math.Abs(got-expected) > 0.0001 {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
@@ -0,0 +1,27 @@
+package loss
+
+// sumOfOutputs sums fn over every output of every sample, each scaled by its weight, and
+// returns the sum together with the total weight. A nil weights slice weighs every output 1.
+func sumOfOutputs(predicted [][]float64, target [][]float64, weights [][]float64, fn func(p float64, y float64) float64) (sum float64, total float64) {
+	for i := range target {
+		for j := range target[i] {
+			w := float64(1)
+			if weights != nil {
+				w = weights[i][j]
+			}
+			sum += w * fn(predicted[i][j], target[i][j])
+			total += w
+		}
+	}
+	return sum, total
+}
+
+// meanOfOutputs averages fn over every output of every sample, weighted by weights. It
+// returns 0 when there is nothing to average, to prevent division by zero.
+func meanOfOutputs(predicted [][]float64, target [][]float64, weights [][]float64, fn func(p float64, y float64) float64) float64 {
+	sum, total := sumOfOutputs(predicted, target, weights, fn)
+	if total == 0 {
+		return 0
+	}
+	return sum / total
+}
@@ -11,21 +11,23 @@ import (
 // to outliers.
 type RMSE struct{}
 
-func (l *RMSE) Calculate(errs []float64) (result float64) {
-	var sum float64
-
-	// If errs is empty, it returns 0 top prevent division by zero
-	if len(errs) == 0 {
-		return 0
-	}
+func (l *RMSE) Calculate(predicted [][]float64, target [][]float64, weights [][]float64) (result float64) {
+	return math.Sqrt(meanOfOutputs(predicted, target, weights, func(p float64, y float64) float64 {
+		return (p - y) * (p - y)
+	}))
+}
 
-	for _, err := range errs {
-		sum += (err * err)
+// Gradient returns (predicted - target) / n. Minimizing the root of the mean squared error is
+// equivalent to minimizing the mean squared error itself, so RMSE trains with the gradient of
+// half the MSE. Like the other losses it is averaged over the n outputs, so the step size at a
+// given learning rate doesn't depend on the loss or the number of outputs.
+func (l *RMSE) Gradient(predicted []float64, target []float64) (grad []float64) {
+	n := float64(len(target))
+	grad = make([]float64, len(target))
+	for i := range target {
+		grad[i] = (predicted[i] - target[i]) / n
 	}
-
-	return math.Sqrt(
-		sum / float64(len(errs)),
-	)
+	return grad
 }
 
 func (l *RMSE) CallMe() string {
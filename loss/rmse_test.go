@@ -1,27 +1,56 @@
 package loss
 
 import (
-	"testing"
 	"math"
+	"testing"
 )
 
-func getExpectedErrors() (errors []float64) {
-	return []float64{
-		0.01, 
-		0.02, 
-		0.34, 
-		0.72,
+func getExpectedErrors() (predicted [][]float64, targets [][]float64) {
+	return [][]float64{
+		{0.01},
+		{0.02},
+		{0.34},
+		{0.72},
+	}, [][]float64{
+		{0},
+		{0},
+		{0},
+		{0},
 	}
 }
 
 func TestCalculate(t *testing.T) {
 	expected := 0.3983
 
-	errors := getExpectedErrors()
+	predicted, targets := getExpectedErrors()
 	loss := RMSE{}
-	got := loss.Calculate(errors)
+	got := loss.Calculate(predicted, targets, nil)
 
-	if math.Abs(got - expected) > 0.0001 {
+	if math.Abs(got-expected) > 0.0001 {
 		t.Errorf("Expected %f, get %f", expected, got)
 	}
 }
+
+func TestCalculateErrorsDoNotCancel(t *testing.T) {
+	expected := 1.0
+
+	loss := RMSE{}
+	got := loss.Calculate([][]float64{{1, 0}}, [][]float64{{0, 1}}, nil)
+
+	if math.Abs(got-expected) > 0.0001 {
+		t.Errorf("Expected %f, get %f", expected, got)
+	}
+}
+
+func TestGradientRMSE(t *testing.T) {
+	expected := []float64{0.05, -0.1}
+
+	loss := RMSE{}
+	got := loss.Gradient([]float64{0.6, 0.3}, []float64{0.5, 0.5})
+
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected[i], got[i])
+		}
+	}
+}
@@ -7,29 +7,45 @@ type (
 	}
 
 	Props struct {
-		Loss      Attr    `json:"loss"`
-		Optimizer Attr    `json:"optimizer"`
-		ErrLimit  float64 `json:"err_limit"`
-		MaxEpoch  int     `json:"max_epoch"`
-		Patience  int     `json:"patience"`
+		Loss           Attr      `json:"loss"`
+		Optimizer      Attr      `json:"optimizer"`
+		Scheduler      Attr      `json:"scheduler"`
+		ErrLimit       float64   `json:"err_limit"`
+		MaxEpoch       int       `json:"max_epoch"`
+		Patience       int       `json:"patience"`
+		WeightDecay    float64   `json:"weight_decay"`
+		DecayBias      bool      `json:"decay_bias"`
+		ClipValue      float64   `json:"clip_value"`
+		ClipNorm       float64   `json:"clip_norm"`
+		ClipGlobalNorm float64   `json:"clip_global_norm"`
+		ClassWeights   []float64 `json:"class_weights"`
+		Regression     bool      `json:"regression"`
+		BatchSize      int       `json:"batch_size"`
+		Shuffle        bool      `json:"shuffle"`
+		Seed           int64     `json:"seed"`
 	}
 
 	Weight struct {
 		Weight [][]float64 `json:"w"`
 		Bias   []float64   `json:"b"`
+		Params []float64   `json:"p,omitempty"`
 	}
 
 	Synaptic struct {
-		SourceSize int    `json:"source_size"`
-		TargetSize int    `json:"target_size"`
-		Weight     Weight `json:"weight"`
-		Activation Attr   `json:"activation"`
+		SourceSize  int    `json:"source_size"`
+		TargetSize  int    `json:"target_size"`
+		Weight      Weight `json:"weight"`
+		Activation  Attr   `json:"activation"`
+		Initializer Attr   `json:"initializer"`
 	}
 
 	Network struct {
 		InputSize  int        `json:"input_size"`
 		OutputSize int        `json:"output_size"`
+		Epoch      int        `json:"epoch"`
+		BaseRate   float64    `json:"base_rate"`
 		Props      Props      `json:"props"`
+		Losses     []float64  `json:"losses"`
 		Synaptics  []Synaptic `json:"synaptics"`
 	}
 )
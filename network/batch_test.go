@@ -0,0 +1,218 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/model"
+	"github.com/harungurubudi/rolade/optimizer"
+)
+
+func TestTrainBatchWeightsSamples(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	a := Sample{Feature: Vector{0, 1}, Target: Vector{1}, Weight: 3}
+	b := Sample{Feature: Vector{1, 0}, Target: Vector{0}}
+
+	_, gradA, err := nt.trainBatch(Samples{a})
+	if err != nil {
+		t.Fatalf("trainBatch error: %v", err)
+	}
+	_, gradB, err := nt.trainBatch(Samples{b})
+	if err != nil {
+		t.Fatalf("trainBatch error: %v", err)
+	}
+	eval, got, err := nt.trainBatch(Samples{a, b})
+	if err != nil {
+		t.Fatalf("trainBatch error: %v", err)
+	}
+
+	for j := range got[0].bias {
+		expected := (3*gradA[0].bias[j] + gradB[0].bias[j]) / 4
+		if math.Abs(got[0].bias[j]-expected) > 1e-12 {
+			t.Errorf("Expected %f, got %f", expected, got[0].bias[j])
+		}
+	}
+
+	if eval.weights[0][0] != 3 || eval.weights[1][0] != 1 {
+		t.Errorf("Expected loss weights [3 1], got %v", eval.weights)
+	}
+}
+
+func TestTrainBatchZeroWeightCountsAsOne(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	a := Sample{Feature: Vector{0, 1}, Target: Vector{1}, Weight: 1}
+	b := Sample{Feature: Vector{1, 0}, Target: Vector{0}, Weight: 3}
+
+	_, unit, err := nt.trainBatch(Samples{a, b})
+	if err != nil {
+		t.Fatalf("trainBatch error: %v", err)
+	}
+	a.Weight = 0
+	eval, unset, err := nt.trainBatch(Samples{a, b})
+	if err != nil {
+		t.Fatalf("trainBatch error: %v", err)
+	}
+
+	for j := range unit[0].bias {
+		if math.Abs(unset[0].bias[j]-unit[0].bias[j]) > 1e-12 {
+			t.Errorf("Expected %f, got %f", unit[0].bias[j], unset[0].bias[j])
+		}
+	}
+	if eval.weights[0][0] != 1 {
+		t.Errorf("Expected loss weight 1, got %f", eval.weights[0][0])
+	}
+}
+
+func TestTrainBatchWeightsClasses(t *testing.T) {
+	nt, err := NewNetwork(2, 2, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	sample := Sample{Feature: Vector{0, 1}, Target: Vector{1, 0}}
+	_, plain, err := nt.trainBatch(Samples{sample})
+	if err != nil {
+		t.Fatalf("trainBatch error: %v", err)
+	}
+
+	nt.SetProps(Props{ClassWeights: []float64{2, 0.5}})
+	_, got, err := nt.trainBatch(Samples{sample})
+	if err != nil {
+		t.Fatalf("trainBatch error: %v", err)
+	}
+
+	for j, factor := range nt.props.ClassWeights {
+		expected := factor * plain[0].bias[j]
+		if math.Abs(got[0].bias[j]-expected) > 1e-12 {
+			t.Errorf("Expected %f, got %f", expected, got[0].bias[j])
+		}
+	}
+
+	// Mismatching class weights are rejected before training starts
+	nt.SetProps(Props{ClassWeights: []float64{1, 1, 1}})
+	if err := nt.Train(Samples{sample}); !errors.Is(err, ErrClassWeightsMismatch) {
+		t.Errorf("Expected ErrClassWeightsMismatch, got %v", err)
+	}
+}
+
+func TestTrainEpochStepsPerMiniBatch(t *testing.T) {
+	nt, err := NewNetwork(1, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+	nt.SetProps(Props{BatchSize: 2, Shuffle: true, Seed: 3})
+
+	var samples Samples
+	for i := range 5 {
+		samples = append(samples, Sample{Feature: Vector{float64(i)}, Target: Vector{float64(i % 2)}})
+	}
+
+	eval, _, err := nt.trainEpoch(context.Background(), samples, nil)
+	if err != nil {
+		t.Fatalf("trainEpoch error: %v", err)
+	}
+
+	// 5 samples in batches of 2 take 3 optimizer steps
+	if nt.clipStats.Updates != 3 {
+		t.Errorf("Expected 3 updates, got %d", nt.clipStats.Updates)
+	}
+	if len(eval.predicted) != samples.Len() {
+		t.Errorf("Expected %d predictions, got %d", samples.Len(), len(eval.predicted))
+	}
+
+	// Shuffling copies the samples instead of reordering the caller's slice
+	for i, sample := range samples {
+		if sample.Feature[0] != float64(i) {
+			t.Errorf("Expected samples to keep their order, got %v at %d", sample.Feature, i)
+		}
+	}
+}
+
+// countingOptimizer counts how often it is stepped.
+type countingOptimizer struct {
+	optimizer.SGD
+	steps int
+}
+
+func (o *countingOptimizer) Step(grads []model.Weight) []model.Weight {
+	o.steps++
+	return o.SGD.Step(grads)
+}
+
+func TestComputeGradientsAveragesSamples(t *testing.T) {
+	nt, err := NewNetwork(2, 2, &activation.Tanh{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+	if err := nt.AddLayer(3, &activation.Sigmoid{}, nil); err != nil {
+		t.Fatalf("AddLayer error: %v", err)
+	}
+	opt := &countingOptimizer{SGD: *optimizer.NewSGDWithLearningRate(0.1)}
+	nt.SetProps(Props{Optimizer: opt, Workers: 2})
+
+	samples := Samples{
+		{Feature: Vector{0, 1}, Target: Vector{1, 0}},
+		{Feature: Vector{1, 0}, Target: Vector{0, 1}},
+		{Feature: Vector{1, 1}, Target: Vector{1, 1}},
+	}
+
+	var perSample []deltas
+	for _, sample := range samples {
+		_, grads, err := nt.trainBatch(Samples{sample})
+		if err != nil {
+			t.Fatalf("trainBatch error: %v", err)
+		}
+		perSample = append(perSample, grads)
+	}
+
+	_, got, err := nt.computeGradients(samples, []int{0, 1, 2})
+	if err != nil {
+		t.Fatalf("computeGradients error: %v", err)
+	}
+
+	mean := func(value func(d deltas) float64) float64 {
+		var sum float64
+		for _, d := range perSample {
+			sum += value(d)
+		}
+		return sum / float64(len(perSample))
+	}
+	for i := range got {
+		for j := range got[i].weight {
+			for k := range got[i].weight[j] {
+				expected := mean(func(d deltas) float64 { return d[i].weight[j][k] })
+				if math.Abs(got[i].weight[j][k]-expected) > 1e-12 {
+					t.Errorf("Expected %f, got %f", expected, got[i].weight[j][k])
+				}
+			}
+		}
+		for j := range got[i].bias {
+			expected := mean(func(d deltas) float64 { return d[i].bias[j] })
+			if math.Abs(got[i].bias[j]-expected) > 1e-12 {
+				t.Errorf("Expected %f, got %f", expected, got[i].bias[j])
+			}
+		}
+	}
+
+	// Computing gradients doesn't step the optimizer, a full-batch epoch steps it once
+	if opt.steps != 0 {
+		t.Errorf("Expected no optimizer step, got %d", opt.steps)
+	}
+	if _, _, err := nt.trainEpoch(context.Background(), samples, nil); err != nil {
+		t.Fatalf("trainEpoch error: %v", err)
+	}
+	if opt.steps != 1 {
+		t.Errorf("Expected 1 optimizer step, got %d", opt.steps)
+	}
+}
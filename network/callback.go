@@ -0,0 +1,198 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+)
+
+// ErrStopTraining is returned by a callback to stop training early. Train then returns nil.
+var ErrStopTraining = errors.New("stop training")
+
+type (
+	// Metrics holds values computed at the end of every epoch, by name. It contains
+	// "learning_rate" and, unless the network is a regression network, "accuracy": the share of
+	// samples whose conclusion (see Test) matched their target during the epoch.
+	Metrics map[string]float64
+
+	// ICallback receives events during training. Callbacks are registered through
+	// Props.Callbacks and called in order.
+	//
+	// Returning ErrStopTraining from OnEpochEnd or OnBatchEnd stops training after the current
+	// update; OnTrainEnd is still called and Train returns nil. Any other error aborts training
+	// and is returned by Train. OnTrainEnd is only called when training finishes or is stopped
+	// by a callback, not when it fails or its context is done.
+	//
+	// OnBatchEnd is called after the batch's update was applied. When it stops training, the
+	// network keeps the updates of the epoch's finished batches, but the partial epoch isn't
+	// counted, its loss isn't recorded and OnEpochEnd isn't called for it.
+	//
+	// Embed BaseCallback to only implement the events you need.
+	ICallback interface {
+		OnTrainBegin(nt *Network) error
+		OnEpochEnd(nt *Network, epoch int, loss float64, metrics Metrics) error
+		OnBatchEnd(nt *Network, batch int, loss float64) error
+		OnTrainEnd(nt *Network, loss float64) error
+	}
+
+	// BaseCallback implements every event of ICallback as a no-op.
+	BaseCallback struct{}
+)
+
+func (BaseCallback) OnTrainBegin(_ *Network) error { return nil }
+
+func (BaseCallback) OnEpochEnd(_ *Network, _ int, _ float64, _ Metrics) error { return nil }
+
+func (BaseCallback) OnBatchEnd(_ *Network, _ int, _ float64) error { return nil }
+
+func (BaseCallback) OnTrainEnd(_ *Network, _ float64) error { return nil }
+
+// EarlyStopping stops training once the loss is low enough, or stops improving.
+//
+// Fields:
+//   - ErrLimit: Training stops once the epoch loss is at or below this value.
+//   - Patience: Training stops after this many epochs without a new lowest loss. Zero disables it.
+type EarlyStopping struct {
+	BaseCallback
+	ErrLimit float64
+	Patience int
+
+	best float64
+	wait int
+}
+
+func NewEarlyStopping(errLimit float64, patience int) *EarlyStopping {
+	return &EarlyStopping{
+		ErrLimit: errLimit,
+		Patience: patience,
+	}
+}
+
+func (c *EarlyStopping) OnTrainBegin(_ *Network) error {
+	c.best = math.MaxFloat64
+	c.wait = 0
+	return nil
+}
+
+func (c *EarlyStopping) OnEpochEnd(_ *Network, _ int, loss float64, _ Metrics) error {
+	if loss < c.best {
+		c.best = loss
+		c.wait = 0
+	} else {
+		c.wait++
+	}
+
+	if loss <= c.ErrLimit {
+		log.Printf("Stopping early: loss (%f) is below threshold", loss)
+		return ErrStopTraining
+	}
+
+	if c.Patience > 0 && c.wait >= c.Patience {
+		log.Printf("Stopping early: no improvement in last %d epochs", c.Patience)
+		return ErrStopTraining
+	}
+	return nil
+}
+
+// Logger logs the training progress.
+//
+// Fields:
+//   - Every: Log every this many epochs. Zero logs 20 times over Props.MaxEpoch.
+type Logger struct {
+	BaseCallback
+	Every int
+
+	every int
+}
+
+func NewLogger(every int) *Logger {
+	return &Logger{
+		Every: every,
+	}
+}
+
+func (c *Logger) OnTrainBegin(nt *Network) error {
+	c.every = c.Every
+	if c.every <= 0 {
+		c.every = nt.props.MaxEpoch / 20
+	}
+	return nil
+}
+
+func (c *Logger) OnEpochEnd(_ *Network, epoch int, loss float64, _ Metrics) error {
+	if c.every > 0 && epoch%c.every == 0 {
+		log.Printf("Training in epoch %d with loss: %f\n", epoch, loss)
+	}
+	return nil
+}
+
+func (c *Logger) OnTrainEnd(_ *Network, loss float64) error {
+	log.Printf("Training completed with final loss: %f", loss)
+	return nil
+}
+
+// Checkpoint saves the network with Network.Save at the end of epochs.
+//
+// Fields:
+//   - Path: Directory the profile is written to.
+//   - BestOnly: Only save when the epoch loss is the lowest seen so far.
+type Checkpoint struct {
+	BaseCallback
+	Path     string
+	BestOnly bool
+
+	best float64
+}
+
+func NewCheckpoint(path string, bestOnly bool) *Checkpoint {
+	return &Checkpoint{
+		Path:     path,
+		BestOnly: bestOnly,
+	}
+}
+
+func (c *Checkpoint) OnTrainBegin(_ *Network) error {
+	c.best = math.MaxFloat64
+	return nil
+}
+
+func (c *Checkpoint) OnEpochEnd(nt *Network, _ int, loss float64, _ Metrics) error {
+	if c.BestOnly && loss >= c.best {
+		return nil
+	}
+	c.best = math.Min(c.best, loss)
+
+	if err := nt.Save(c.Path); err != nil {
+		return fmt.Errorf("got error while saving checkpoint: %v", err)
+	}
+	return nil
+}
+
+// callbacks returns the callbacks of a training run: Props.Callbacks, or when none are set,
+// an EarlyStopping built from Props.ErrLimit and Props.Patience followed by a Logger. These two
+// props are only used for this default.
+func (nt *Network) callbacks() []ICallback {
+	if len(nt.props.Callbacks) > 0 {
+		return nt.props.Callbacks
+	}
+	return []ICallback{
+		NewEarlyStopping(nt.props.ErrLimit, nt.props.Patience),
+		&Logger{},
+	}
+}
+
+// notify calls event for every callback, in order. All callbacks receive the event even if
+// one asks to stop; stop reports whether any did. A callback error other than ErrStopTraining
+// is returned right away.
+func notify(callbacks []ICallback, event func(cb ICallback) error) (stop bool, err error) {
+	for _, cb := range callbacks {
+		if err := event(cb); err != nil {
+			if !errors.Is(err, ErrStopTraining) {
+				return false, err
+			}
+			stop = true
+		}
+	}
+	return stop, nil
+}
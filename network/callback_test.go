@@ -0,0 +1,195 @@
+package network
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+)
+
+// recordingCallback records the events it receives and stops training after a given number of
+// epochs.
+type recordingCallback struct {
+	BaseCallback
+	stopAfter int
+	began     int
+	epochs    []int
+	batches   int
+	ended     int
+	metrics   Metrics
+}
+
+func (c *recordingCallback) OnTrainBegin(_ *Network) error {
+	c.began++
+	return nil
+}
+
+func (c *recordingCallback) OnEpochEnd(_ *Network, epoch int, _ float64, metrics Metrics) error {
+	c.epochs = append(c.epochs, epoch)
+	c.metrics = metrics
+	if len(c.epochs) == c.stopAfter {
+		return ErrStopTraining
+	}
+	return nil
+}
+
+func (c *recordingCallback) OnBatchEnd(_ *Network, _ int, _ float64) error {
+	c.batches++
+	return nil
+}
+
+func (c *recordingCallback) OnTrainEnd(_ *Network, _ float64) error {
+	c.ended++
+	return nil
+}
+
+func TestTrainCallbackEvents(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	cb := &recordingCallback{stopAfter: 3}
+	nt.SetProps(Props{BatchSize: 2, MaxEpoch: 10, Callbacks: []ICallback{cb}})
+
+	if err := nt.Train(getContextTestSamples()); err != nil {
+		t.Fatalf("Train error: %v", err)
+	}
+
+	if cb.began != 1 || cb.ended != 1 {
+		t.Errorf("Expected one begin and one end event, got %d and %d", cb.began, cb.ended)
+	}
+	if len(cb.epochs) != 3 || cb.epochs[2] != 2 {
+		t.Errorf("Expected epochs [0 1 2], got %v", cb.epochs)
+	}
+	// 4 samples in batches of 2 take 2 batches per epoch
+	if cb.batches != 6 {
+		t.Errorf("Expected 6 batch events, got %d", cb.batches)
+	}
+	if nt.epoch != 3 {
+		t.Errorf("Expected training to stop after 3 epochs, got %d", nt.epoch)
+	}
+
+	if _, ok := cb.metrics["learning_rate"]; !ok {
+		t.Errorf("Expected a learning_rate metric, got %v", cb.metrics)
+	}
+	if accuracy, ok := cb.metrics["accuracy"]; !ok || accuracy < 0 || accuracy > 1 {
+		t.Errorf("Expected an accuracy metric in [0, 1], got %v", cb.metrics)
+	}
+}
+
+// failingCallback fails at the end of the first batch.
+type failingCallback struct {
+	BaseCallback
+	err   error
+	ended bool
+}
+
+func (c *failingCallback) OnBatchEnd(_ *Network, _ int, _ float64) error {
+	return c.err
+}
+
+func (c *failingCallback) OnTrainEnd(_ *Network, _ float64) error {
+	c.ended = true
+	return nil
+}
+
+func TestTrainCallbackError(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	cb := &failingCallback{err: errors.New("disk full")}
+	nt.SetProps(Props{MaxEpoch: 10, Callbacks: []ICallback{cb}})
+
+	if err := nt.Train(getContextTestSamples()); err == nil {
+		t.Fatalf("Expected the callback error to be returned")
+	}
+	if cb.ended {
+		t.Errorf("Expected OnTrainEnd not to be called after an error")
+	}
+	if nt.epoch != 0 {
+		t.Errorf("Expected the failed epoch not to be counted, got %d", nt.epoch)
+	}
+}
+
+func TestEarlyStopping(t *testing.T) {
+	cb := NewEarlyStopping(0.1, 2)
+	_ = cb.OnTrainBegin(nil)
+
+	losses := []float64{0.5, 0.4, 0.4, 0.45}
+	for i, loss := range losses {
+		err := cb.OnEpochEnd(nil, i, loss, nil)
+		stop := errors.Is(err, ErrStopTraining)
+		if expected := i == len(losses)-1; stop != expected {
+			t.Errorf("Expected stop %v at epoch %d, got %v", expected, i, stop)
+		}
+	}
+
+	_ = cb.OnTrainBegin(nil)
+	if err := cb.OnEpochEnd(nil, 0, 0.05, nil); !errors.Is(err, ErrStopTraining) {
+		t.Errorf("Expected to stop below the error limit, got %v", err)
+	}
+}
+
+func TestCheckpoint(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	path := t.TempDir()
+	nt.SetProps(Props{MaxEpoch: 3, Callbacks: []ICallback{NewCheckpoint(path, true)}})
+	if err := nt.Train(getContextTestSamples()); err != nil {
+		t.Fatalf("Train error: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+	if loaded.epoch == 0 {
+		t.Errorf("Expected a checkpoint of a trained epoch")
+	}
+}
+
+// batchStopper stops training at the end of the given batch.
+type batchStopper struct {
+	BaseCallback
+	at     int
+	epochs int
+}
+
+func (c *batchStopper) OnEpochEnd(_ *Network, _ int, _ float64, _ Metrics) error {
+	c.epochs++
+	return nil
+}
+
+func (c *batchStopper) OnBatchEnd(_ *Network, batch int, _ float64) error {
+	if batch == c.at {
+		return ErrStopTraining
+	}
+	return nil
+}
+
+func TestTrainStopAtBatchEnd(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	cb := &batchStopper{at: 1}
+	nt.SetProps(Props{BatchSize: 1, MaxEpoch: 10, Callbacks: []ICallback{cb}})
+	if err := nt.Train(getContextTestSamples()); err != nil {
+		t.Fatalf("Train error: %v", err)
+	}
+
+	// The two finished batches are applied, the partial epoch is not counted
+	if nt.clipStats.Updates != 2 {
+		t.Errorf("Expected 2 updates, got %d", nt.clipStats.Updates)
+	}
+	if nt.epoch != 0 || cb.epochs != 0 || len(nt.lossHistories) != 0 {
+		t.Errorf("Expected the partial epoch not to be counted, got epoch %d", nt.epoch)
+	}
+}
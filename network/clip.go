@@ -0,0 +1,130 @@
+package network
+
+import (
+	"log"
+	"math"
+)
+
+// ClipStats reports how often gradient clipping fired during training.
+//
+// Updates counts every optimizer update. Value and GlobalNorm count the updates in which
+// at least one gradient value, or the global norm, was clipped. LayerNorm counts clipped
+// layers, so a single update may add more than one.
+type ClipStats struct {
+	Updates    int
+	Value      int
+	LayerNorm  int
+	GlobalNorm int
+}
+
+// ClipStats returns the gradient clipping counters accumulated since the network was created
+// or loaded.
+func (nt *Network) ClipStats() ClipStats {
+	return nt.clipStats
+}
+
+// logClipStats reports how often gradient clipping fired, if it is enabled.
+func (nt *Network) logClipStats() {
+	stats := nt.clipStats
+	if stats.Value+stats.LayerNorm+stats.GlobalNorm == 0 {
+		return
+	}
+
+	log.Printf("Gradient clipping over %d updates: fired %d times by value, %d by layer norm, %d by global norm",
+		stats.Updates, stats.Value, stats.LayerNorm, stats.GlobalNorm)
+}
+
+// clip applies the configured gradient clipping to the merged gradients in place, before they
+// are handed to the optimizer. Clipping is done in the order value, layer norm, global norm.
+func (nt *Network) clip(grads deltas) {
+	nt.clipStats.Updates++
+
+	if nt.props.ClipValue > 0 && clipValue(grads, nt.props.ClipValue) {
+		nt.clipStats.Value++
+	}
+
+	if nt.props.ClipNorm > 0 {
+		for i := range grads {
+			norm := math.Sqrt(squaredNorm(grads[i]))
+			if norm > nt.props.ClipNorm {
+				scale(grads[i], nt.props.ClipNorm/norm)
+				nt.clipStats.LayerNorm++
+			}
+		}
+	}
+
+	if nt.props.ClipGlobalNorm > 0 {
+		var sum float64
+		for i := range grads {
+			sum += squaredNorm(grads[i])
+		}
+
+		norm := math.Sqrt(sum)
+		if norm > nt.props.ClipGlobalNorm {
+			for i := range grads {
+				scale(grads[i], nt.props.ClipGlobalNorm/norm)
+			}
+			nt.clipStats.GlobalNorm++
+		}
+	}
+}
+
+// clipValue limits every gradient to [-limit, limit] and reports whether any value changed.
+func clipValue(grads deltas, limit float64) (clipped bool) {
+	clamp := func(val *float64) {
+		if *val > limit {
+			*val = limit
+			clipped = true
+		} else if *val < -limit {
+			*val = -limit
+			clipped = true
+		}
+	}
+
+	for i := range grads {
+		for j := range grads[i].weight {
+			for k := range grads[i].weight[j] {
+				clamp(&grads[i].weight[j][k])
+			}
+		}
+		for j := range grads[i].bias {
+			clamp(&grads[i].bias[j])
+		}
+		for j := range grads[i].params {
+			clamp(&grads[i].params[j])
+		}
+	}
+
+	return clipped
+}
+
+// squaredNorm returns the squared L2 norm of a layer's weights, biases and activation parameters.
+func squaredNorm(w weight) (sum float64) {
+	for j := range w.weight {
+		for _, val := range w.weight[j] {
+			sum += val * val
+		}
+	}
+	for _, val := range w.bias {
+		sum += val * val
+	}
+	for _, val := range w.params {
+		sum += val * val
+	}
+	return sum
+}
+
+// scale multiplies a layer's weights, biases and activation parameters by factor.
+func scale(w weight, factor float64) {
+	for j := range w.weight {
+		for k := range w.weight[j] {
+			w.weight[j][k] *= factor
+		}
+	}
+	for j := range w.bias {
+		w.bias[j] *= factor
+	}
+	for j := range w.params {
+		w.params[j] *= factor
+	}
+}
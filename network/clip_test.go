@@ -0,0 +1,69 @@
+package network
+
+import (
+	"math"
+	"testing"
+)
+
+func getClipGradients() deltas {
+	return deltas{
+		{
+			weight: [][]float64{{3, -4}},
+			bias:   []float64{0},
+		},
+		{
+			weight: [][]float64{{0.3}, {0.4}},
+			bias:   []float64{0},
+		},
+	}
+}
+
+func TestClipValue(t *testing.T) {
+	nt := &Network{props: Props{ClipValue: 1}}
+	grads := getClipGradients()
+	nt.clip(grads)
+
+	if grads[0].weight[0][0] != 1 || grads[0].weight[0][1] != -1 {
+		t.Errorf("Expected [1 -1], got %v", grads[0].weight[0])
+	}
+	if grads[1].weight[0][0] != 0.3 {
+		t.Errorf("Expected 0.3 to stay untouched, got %f", grads[1].weight[0][0])
+	}
+	if nt.ClipStats().Value != 1 {
+		t.Errorf("Expected value clipping to fire once, got %d", nt.ClipStats().Value)
+	}
+}
+
+func TestClipLayerNorm(t *testing.T) {
+	nt := &Network{props: Props{ClipNorm: 1}}
+	grads := getClipGradients()
+	nt.clip(grads)
+
+	if got := math.Sqrt(squaredNorm(grads[0])); math.Abs(got-1) > 1e-9 {
+		t.Errorf("Expected first layer norm 1, got %f", got)
+	}
+	if got := math.Sqrt(squaredNorm(grads[1])); math.Abs(got-0.5) > 1e-9 {
+		t.Errorf("Expected second layer norm 0.5, got %f", got)
+	}
+	if nt.ClipStats().LayerNorm != 1 {
+		t.Errorf("Expected layer norm clipping to fire once, got %d", nt.ClipStats().LayerNorm)
+	}
+}
+
+func TestClipGlobalNorm(t *testing.T) {
+	nt := &Network{props: Props{ClipGlobalNorm: 1}}
+	grads := getClipGradients()
+	nt.clip(grads)
+
+	global := math.Sqrt(squaredNorm(grads[0]) + squaredNorm(grads[1]))
+	if math.Abs(global-1) > 1e-9 {
+		t.Errorf("Expected global norm 1, got %f", global)
+	}
+	// Direction is preserved: the ratio between layers doesn't change
+	if math.Abs(grads[0].weight[0][0]/grads[1].weight[0][0]-10) > 1e-9 {
+		t.Errorf("Expected clipping to scale all layers equally")
+	}
+	if nt.ClipStats().GlobalNorm != 1 {
+		t.Errorf("Expected global norm clipping to fire once, got %d", nt.ClipStats().GlobalNorm)
+	}
+}
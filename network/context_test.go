@@ -0,0 +1,99 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/loss"
+)
+
+// cancellingLoss cancels its context after computing the gradient of a given number of samples.
+type cancellingLoss struct {
+	loss.MSE
+	cancel context.CancelFunc
+	after  int
+	calls  int
+}
+
+func (l *cancellingLoss) Gradient(predicted []float64, target []float64) []float64 {
+	l.calls++
+	if l.calls == l.after {
+		l.cancel()
+	}
+	return l.MSE.Gradient(predicted, target)
+}
+
+func getContextTestSamples() Samples {
+	return Samples{
+		{Feature: Vector{0, 1}, Target: Vector{1}},
+		{Feature: Vector{1, 0}, Target: Vector{0}},
+		{Feature: Vector{1, 1}, Target: Vector{1}},
+		{Feature: Vector{0, 0}, Target: Vector{0}},
+	}
+}
+
+func TestTrainContextStopsBetweenBatches(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nt.SetProps(Props{
+		Loss:      &cancellingLoss{cancel: cancel, after: 2},
+		BatchSize: 1,
+		Workers:   1,
+		MaxEpoch:  10,
+	})
+
+	err = nt.TrainContext(ctx, getContextTestSamples())
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	// The batch that cancelled is still applied, the next one is not started
+	if nt.clipStats.Updates != 2 {
+		t.Errorf("Expected 2 updates, got %d", nt.clipStats.Updates)
+	}
+	if nt.epoch != 0 {
+		t.Errorf("Expected the interrupted epoch not to be counted, got %d", nt.epoch)
+	}
+}
+
+func TestTrainContextCancelledBeforeStart(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+	before := cloneWeight(nt.synaptics[0].weight)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := nt.TrainContext(ctx, getContextTestSamples()); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if !reflect.DeepEqual(before, nt.synaptics[0].weight) {
+		t.Errorf("Expected weights to be untouched")
+	}
+}
+
+func TestTrainContextDeadline(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+	nt.SetProps(Props{MaxEpoch: 1 << 30, Patience: 1 << 30, ErrLimit: 1e-300})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := nt.TrainContext(ctx, getContextTestSamples()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
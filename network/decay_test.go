@@ -0,0 +1,87 @@
+package network
+
+import (
+	"math"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/optimizer"
+)
+
+// zeroGradients returns all-zero gradients shaped like the layers of nt.
+func zeroGradients(nt *Network) deltas {
+	grads := make(deltas, len(nt.synaptics))
+	for i, sy := range nt.synaptics {
+		grads[i] = weight{
+			weight: make([][]float64, sy.sourceSize),
+			bias:   make([]float64, sy.targetSize),
+		}
+		for j := range grads[i].weight {
+			grads[i].weight[j] = make([]float64, sy.targetSize)
+		}
+	}
+	return grads
+}
+
+func TestStepWeightDecay(t *testing.T) {
+	for _, decayBias := range []bool{false, true} {
+		nt, err := NewNetwork(2, 3, &activation.Sigmoid{}, nil)
+		if err != nil {
+			t.Fatalf("NewNetwork error: %v", err)
+		}
+		nt.SetProps(Props{
+			Optimizer:   optimizer.NewSGDWithLearningRate(0.1),
+			WeightDecay: 0.5,
+			DecayBias:   decayBias,
+		})
+		nt.synaptics[0].weight.bias = []float64{0.2, -0.4, 1}
+
+		before := cloneWeight(nt.synaptics[0].weight)
+		nt.step(zeroGradients(nt))
+		after := nt.synaptics[0].weight
+
+		// With a zero gradient only the decay of alpha * decay * w = 0.05w remains
+		for j := range before.weight {
+			for k := range before.weight[j] {
+				expected := before.weight[j][k] * (1 - 0.1*0.5)
+				if math.Abs(after.weight[j][k]-expected) > 1e-12 {
+					t.Errorf("Expected %f, got %f", expected, after.weight[j][k])
+				}
+			}
+		}
+
+		for j := range before.bias {
+			expected := before.bias[j]
+			if decayBias {
+				expected *= 1 - 0.1*0.5
+			}
+			if math.Abs(after.bias[j]-expected) > 1e-12 {
+				t.Errorf("DecayBias %v: Expected %f, got %f", decayBias, expected, after.bias[j])
+			}
+		}
+	}
+}
+
+func TestSaveLoadKeepsWeightDecay(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+	nt.SetProps(Props{WeightDecay: 0.01, DecayBias: true})
+
+	path := t.TempDir()
+	if err := nt.Save(path); err != nil {
+		t.Fatalf("Save error: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+
+	if loaded.props.WeightDecay != 0.01 {
+		t.Errorf("Expected %f, got %f", 0.01, loaded.props.WeightDecay)
+	}
+	if !loaded.props.DecayBias {
+		t.Errorf("Expected DecayBias to be restored")
+	}
+}
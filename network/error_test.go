@@ -0,0 +1,99 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+)
+
+func TestTrainValidatesSamples(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	samples := Samples{
+		{Feature: Vector{0, 1}, Target: Vector{1}},
+		{Feature: Vector{1, 0, 1}, Target: Vector{0}},
+		{Feature: Vector{1, 1}, Target: Vector{0, 1}},
+	}
+
+	err = nt.Train(samples)
+	if !errors.Is(err, ErrFeatureSizeMismatch) {
+		t.Errorf("Expected ErrFeatureSizeMismatch, got %v", err)
+	}
+	if !errors.Is(err, ErrTargetSizeMismatch) {
+		t.Errorf("Expected ErrTargetSizeMismatch, got %v", err)
+	}
+
+	var sampleErr *SampleError
+	if !errors.As(err, &sampleErr) || sampleErr.Index != 1 {
+		t.Errorf("Expected the first error to point to sample 1, got %v", err)
+	}
+	if nt.epoch != 0 {
+		t.Errorf("Expected no epoch to be trained, got %d", nt.epoch)
+	}
+}
+
+func TestTrainEpochReportsDatasetIndex(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+	nt.SetProps(Props{BatchSize: 2, Shuffle: true, Seed: 9, Workers: 2})
+
+	samples := Samples{
+		{Feature: Vector{0, 1}, Target: Vector{1}},
+		{Feature: Vector{1, 0}, Target: Vector{0}},
+		{Feature: Vector{1}, Target: Vector{0}},
+		{Feature: Vector{0, 0}, Target: Vector{1}},
+		{Feature: Vector{1, 1, 1}, Target: Vector{0}},
+	}
+
+	// trainEpoch skips the up-front validation, so the failures come from the batches
+	_, _, err = nt.trainEpoch(context.Background(), samples, nil)
+	if err == nil {
+		t.Fatalf("Expected an error for mis-sized features")
+	}
+
+	found := map[int]bool{}
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var sampleErr *SampleError
+		if errors.As(e, &sampleErr) {
+			found[sampleErr.Index] = true
+		}
+	}
+	// Training stops at the first failing mini-batch, which holds at least one of them
+	for index := range found {
+		if index != 2 && index != 4 {
+			t.Errorf("Expected errors for samples 2 and 4 only, got %d", index)
+		}
+	}
+	if len(found) == 0 {
+		t.Errorf("Expected sample errors, got %v", err)
+	}
+}
+
+func TestTrainRejectsNegativeWeight(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	samples := Samples{
+		{Feature: Vector{0, 1}, Target: Vector{1}},
+		{Feature: Vector{1, 0}, Target: Vector{0}, Weight: -1},
+	}
+
+	err = nt.Train(samples)
+	if !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("Expected ErrNegativeWeight, got %v", err)
+	}
+
+	var sampleErr *SampleError
+	if !errors.As(err, &sampleErr) || sampleErr.Index != 1 {
+		t.Errorf("Expected the error to point to sample 1, got %v", err)
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"time"
 
 	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/initializer"
 	"github.com/harungurubudi/rolade/loss"
 	"github.com/harungurubudi/rolade/model"
 	"github.com/harungurubudi/rolade/optimizer"
+	"github.com/harungurubudi/rolade/scheduler"
 )
 
 // NewNetwork creates and initializes a new feedforward neural network with a single layer,
@@ -18,10 +21,15 @@ import (
 // The network is configured with default training properties: RMSE loss,
 // SGD optimizer, a max error threshold of 0.001, and up to 1000 training epochs.
 //
+// weightInit draws the layer's weights and biases. When nil, a default suited to the
+// activation is used (see initializer.Default).
+//
 // Returns the initialized *Network or an error if layer creation fails.
-func NewNetwork(inputSize int, outputSize int, activation activation.IActivation) (nt *Network, err error) {
+func NewNetwork(inputSize int, outputSize int, activation activation.IActivation, weightInit initializer.IInitializer) (nt *Network, err error) {
+	rng := newRand(0)
+
 	var synaptics []synaptic
-	sy, err := generateSynaptic(inputSize, outputSize, activation)
+	sy, err := generateSynaptic(inputSize, outputSize, activation, weightInit, rng)
 	if err != nil {
 		return nt, fmt.Errorf("got error while add layer: %v", err)
 	}
@@ -39,6 +47,8 @@ func NewNetwork(inputSize int, outputSize int, activation activation.IActivation
 			Patience:  1000,
 		},
 		synaptics: synaptics,
+		rand:      rng,
+		workers:   newPool(0),
 	}
 
 	return nt, nil
@@ -77,6 +87,15 @@ func Load(path string) (*Network, error) {
 			return nil, fmt.Errorf("error loading activation: %w", err)
 		}
 
+		// Profiles saved before initializers were stored use the activation's default
+		weightInit := initializer.Default(act)
+		if src.Initializer.Name != "" {
+			weightInit, err = initializer.Load(&src.Initializer)
+			if err != nil {
+				return nil, fmt.Errorf("error loading initializer: %w", err)
+			}
+		}
+
 		synaptics = append(synaptics, synaptic{
 			sourceSize: src.SourceSize,
 			targetSize: src.TargetSize,
@@ -84,7 +103,8 @@ func Load(path string) (*Network, error) {
 				weight: src.Weight.Weight,
 				bias:   src.Weight.Bias,
 			},
-			activation: act,
+			activation:  act,
+			initializer: weightInit,
 		})
 	}
 
@@ -99,61 +119,115 @@ func Load(path string) (*Network, error) {
 		return nil, fmt.Errorf("error loading optimizer: %w", err)
 	}
 
+	// The scheduler is optional
+	var schedulerFunc scheduler.IScheduler
+	if profile.Props.Scheduler.Name != "" {
+		schedulerFunc, err = scheduler.Load(&profile.Props.Scheduler)
+		if err != nil {
+			return nil, fmt.Errorf("error loading scheduler: %w", err)
+		}
+	}
+
 	// Return the fully reconstructed network
 	return &Network{
 		inputSize:  profile.InputSize,
 		outputSize: profile.OutputSize,
 		props: Props{
-			Loss:      lossFunc,
-			Optimizer: optimizerFunc,
-			ErrLimit:  profile.Props.ErrLimit,
-			MaxEpoch:  profile.Props.MaxEpoch,
-			Patience:  profile.Props.Patience,
+			Loss:           lossFunc,
+			Optimizer:      optimizerFunc,
+			Scheduler:      schedulerFunc,
+			ErrLimit:       profile.Props.ErrLimit,
+			MaxEpoch:       profile.Props.MaxEpoch,
+			Patience:       profile.Props.Patience,
+			WeightDecay:    profile.Props.WeightDecay,
+			DecayBias:      profile.Props.DecayBias,
+			ClipValue:      profile.Props.ClipValue,
+			ClipNorm:       profile.Props.ClipNorm,
+			ClipGlobalNorm: profile.Props.ClipGlobalNorm,
+			ClassWeights:   profile.Props.ClassWeights,
+			Regression:     profile.Props.Regression,
+			BatchSize:      profile.Props.BatchSize,
+			Shuffle:        profile.Props.Shuffle,
+			Seed:           profile.Props.Seed,
 		},
-		synaptics: synaptics,
+		synaptics:     synaptics,
+		lossHistories: profile.Losses,
+		epoch:         profile.Epoch,
+		baseRate:      profile.BaseRate,
+		rand:          newRand(profile.Props.Seed),
+		workers:       newPool(0),
+		trained:       true,
 	}, nil
 }
 
-func generateSynaptic(sourceSize int, targetSize int, activation activation.IActivation) (sy synaptic, err error) {
-	var w [][]float64
-	for range sourceSize {
-		var tmp []float64
-		for range targetSize {
-			tmp = append(tmp, getRandomFloat(-0.5, 0.5))
-		}
-		w = append(w, tmp)
-	}
-
-	var b []float64
-	for range targetSize {
-		b = append(b, getRandomFloat(-0.5, 0.5))
+// generateSynaptic creates a layer with weights and biases drawn by weightInit from rng.
+// When weightInit is nil, the default initializer for the activation is used.
+func generateSynaptic(sourceSize int, targetSize int, activation activation.IActivation, weightInit initializer.IInitializer, rng *rand.Rand) (sy synaptic, err error) {
+	if weightInit == nil {
+		weightInit = initializer.Default(activation)
 	}
 
 	result := synaptic{
 		sourceSize: sourceSize,
 		targetSize: targetSize,
 		weight: weight{
-			weight: w,
-			bias:   b,
+			weight: weightInit.Weight(sourceSize, targetSize, rng),
+			bias:   weightInit.Bias(targetSize, rng),
 		},
-		activation: activation,
+		activation:  activation,
+		initializer: weightInit,
 	}
 	return result, nil
 }
 
-func getRandomFloat(min, max float64) float64 {
-	return min + rand.Float64()*(max-min)
+// newRand returns a random source seeded with seed, or with the current time when seed is 0.
+func newRand(seed int64) *rand.Rand {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return rand.New(rand.NewSource(seed))
 }
 
-func mean(vals Vector) (result float64, err error) {
-	if len(vals) == 0 {
-		return result, fmt.Errorf("got error while calculating mean : division by zero")
+// toModelWeights exposes deltas in the layout used by the optimizer package.
+// The underlying slices are shared, not copied.
+func toModelWeights(d deltas) []model.Weight {
+	result := make([]model.Weight, len(d))
+	for i, w := range d {
+		result[i] = model.Weight{
+			Weight: w.weight,
+			Bias:   w.bias,
+			Params: w.params,
+		}
 	}
+	return result
+}
 
-	var sum float64
-	for _, val := range vals {
-		sum += val
+// fromModelWeights is the inverse of toModelWeights.
+func fromModelWeights(ws []model.Weight) deltas {
+	result := make(deltas, len(ws))
+	for i, w := range ws {
+		result[i] = weight{
+			weight: w.Weight,
+			bias:   w.Bias,
+			params: w.Params,
+		}
 	}
+	return result
+}
 
-	return sum / float64(len(vals)), nil
+// argmax returns a one-hot vector marking the largest value of vals.
+func argmax(vals Vector) []int {
+	result := make([]int, len(vals))
+	if len(vals) == 0 {
+		return result
+	}
+
+	best := 0
+	for i, val := range vals {
+		if val > vals[best] {
+			best = i
+		}
+	}
+	result[best] = 1
+	return result
 }
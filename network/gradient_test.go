@@ -0,0 +1,73 @@
+package network
+
+import (
+	"math"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/loss"
+)
+
+// TestGradientMatchesFiniteDifference checks the backpropagated weight gradients against
+// numerical derivatives of the loss, with every kind of activation in the hidden layer.
+func TestGradientMatchesFiniteDifference(t *testing.T) {
+	hidden := []activation.IActivation{
+		&activation.Sigmoid{},
+		&activation.Tanh{},
+		&activation.ReLU{},
+		activation.NewLeakyReLU(0.1),
+		activation.NewELU(1),
+		&activation.SELU{},
+		activation.NewPReLU(),
+		activation.NewGELU(false),
+		activation.NewSwish(1.5),
+		&activation.Mish{},
+		&activation.Softplus{},
+	}
+
+	sample := Sample{Feature: Vector{0.6, -0.9, 0.3}, Target: Vector{0.4, -0.2}}
+	const h = 1e-6
+
+	for _, act := range hidden {
+		nt, err := NewNetwork(3, 2, act, nil)
+		if err != nil {
+			t.Fatalf("NewNetwork error: %v", err)
+		}
+		if err := nt.AddLayer(4, &activation.Identity{}, nil); err != nil {
+			t.Fatalf("AddLayer error: %v", err)
+		}
+		nt.SetProps(Props{Loss: &loss.MSE{}})
+
+		lossOf := func() float64 {
+			_, outputs, err := nt.forward(sample.Feature)
+			if err != nil {
+				t.Fatalf("forward error: %v", err)
+			}
+			return nt.props.Loss.Calculate([][]float64{outputs[len(outputs)-1]}, [][]float64{sample.Target}, nil)
+		}
+
+		_, grads, err := nt.trainBatch(Samples{sample})
+		if err != nil {
+			t.Fatalf("trainBatch error: %v", err)
+		}
+
+		for l := range nt.synaptics {
+			w := nt.synaptics[l].weight.weight
+			for i := range w {
+				for j := range w[i] {
+					original := w[i][j]
+					w[i][j] = original + h
+					plus := lossOf()
+					w[i][j] = original - h
+					minus := lossOf()
+					w[i][j] = original
+
+					expected := (plus - minus) / (2 * h)
+					if math.Abs(grads[l].weight[i][j]-expected) > 1e-5 {
+						t.Errorf("%s layer %d weight [%d][%d]: Expected %f, got %f", act.CallMe(), l, i, j, expected, grads[l].weight[i][j])
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/initializer"
+)
+
+func TestAddLayerKeepsInitializer(t *testing.T) {
+	nt, err := NewNetwork(3, 2, &activation.Tanh{}, initializer.NewConstant(0.1))
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+	if err := nt.AddLayer(4, &activation.Sigmoid{}, initializer.NewConstant(0.2)); err != nil {
+		t.Fatalf("AddLayer error: %v", err)
+	}
+
+	// The first layer is redrawn as 3x4 with its own initializer
+	for l, expected := range []float64{0.1, 0.2} {
+		sy := nt.synaptics[l]
+		for _, row := range append(sy.weight.weight, sy.weight.bias) {
+			for _, val := range row {
+				if val != expected {
+					t.Errorf("Layer %d: Expected %f, got %f", l, expected, val)
+				}
+			}
+		}
+	}
+	if len(nt.synaptics[0].weight.weight) != 3 || len(nt.synaptics[0].weight.bias) != 4 {
+		t.Errorf("Expected first layer to be 3x4")
+	}
+}
+
+func TestLoadKeepsInitializer(t *testing.T) {
+	nt, err := NewNetwork(3, 2, &activation.Tanh{}, initializer.NewUniform(-0.1, 0.1))
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+	if err := nt.AddLayer(4, &activation.ReLU{}, nil); err != nil {
+		t.Fatalf("AddLayer error: %v", err)
+	}
+
+	path := t.TempDir()
+	if err := nt.Save(path); err != nil {
+		t.Fatalf("Save error: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+
+	uniform, ok := loaded.synaptics[0].initializer.(*initializer.Uniform)
+	if !ok || uniform.Min != -0.1 || uniform.Max != 0.1 {
+		t.Errorf("Expected Uniform [-0.1, 0.1], got %#v", loaded.synaptics[0].initializer)
+	}
+	if _, ok := loaded.synaptics[1].initializer.(*initializer.HeNormal); !ok {
+		t.Errorf("Expected HeNormal, got %T", loaded.synaptics[1].initializer)
+	}
+}
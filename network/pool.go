@@ -0,0 +1,92 @@
+package network
+
+import (
+	"runtime"
+	"sync"
+)
+
+// pool is a fixed set of worker goroutines owned by a Network. It is shared by the forward
+// pass, backpropagation and weight updates, so the amount of parallelism is bounded by its
+// size no matter how many samples or neurons there are.
+//
+// The goroutine that hands out work takes part in it: a task is given to a worker only if one
+// is idle, and run by the caller otherwise. That makes nested use (e.g. a forward pass started
+// from a worker) safe from deadlocks.
+type pool struct {
+	size  int
+	tasks chan func()
+}
+
+// newPool starts a pool of size goroutines, counting the caller, so size-1 workers are started.
+// A size of 0 or less uses runtime.GOMAXPROCS. The workers stop once the pool is garbage
+// collected or closed.
+func newPool(size int) *pool {
+	if size <= 0 {
+		size = runtime.GOMAXPROCS(0)
+	}
+
+	p := &pool{
+		size:  size,
+		tasks: make(chan func()),
+	}
+
+	// Workers only reference the channel, so they don't keep the pool alive
+	for range size - 1 {
+		go work(p.tasks)
+	}
+	runtime.SetFinalizer(p, (*pool).close)
+
+	return p
+}
+
+func work(tasks <-chan func()) {
+	for task := range tasks {
+		task()
+	}
+}
+
+// close stops the workers. The pool must not be used afterwards.
+func (p *pool) close() {
+	runtime.SetFinalizer(p, nil)
+	close(p.tasks)
+}
+
+// parallel calls fn for every i in [0, n) and returns once all calls are done. Calls are
+// handed to idle workers and run by the caller when none is idle.
+func (p *pool) parallel(n int, fn func(i int)) {
+	if n == 1 || p.size == 1 {
+		for i := range n {
+			fn(i)
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := range n {
+		task := func() {
+			defer wg.Done()
+			fn(i)
+		}
+
+		select {
+		case p.tasks <- task:
+		default:
+			task()
+		}
+	}
+	wg.Wait()
+}
+
+// spread splits [0, n) into at most one contiguous range per goroutine of the pool and calls
+// fn for every range in parallel.
+func (p *pool) spread(n int, fn func(start int, end int)) {
+	if n <= 0 {
+		return
+	}
+
+	chunk := (n + p.size - 1) / p.size
+	p.parallel((n+chunk-1)/chunk, func(i int) {
+		fn(i*chunk, min((i+1)*chunk, n))
+	})
+}
@@ -0,0 +1,75 @@
+package network
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+)
+
+func TestPoolParallel(t *testing.T) {
+	p := newPool(4)
+	defer p.close()
+
+	// Nested calls must not deadlock, and every index must run exactly once
+	counts := make([]int32, 50)
+	p.parallel(5, func(i int) {
+		p.parallel(10, func(j int) {
+			atomic.AddInt32(&counts[i*10+j], 1)
+		})
+	})
+
+	for i, count := range counts {
+		if count != 1 {
+			t.Errorf("Expected index %d to run once, ran %d times", i, count)
+		}
+	}
+}
+
+func TestPoolSpread(t *testing.T) {
+	p := newPool(3)
+	defer p.close()
+
+	covered := make([]int32, 10)
+	p.spread(len(covered), func(start int, end int) {
+		for i := start; i < end; i++ {
+			atomic.AddInt32(&covered[i], 1)
+		}
+	})
+
+	for i, count := range covered {
+		if count != 1 {
+			t.Errorf("Expected index %d to be covered once, got %d", i, count)
+		}
+	}
+}
+
+func TestWorkersDontChangeResults(t *testing.T) {
+	// Layers wider than asyncProcessThreshold are spread over the workers
+	train := func(workers int) *Network {
+		nt, err := NewNetwork(3, 2, &activation.Tanh{}, nil)
+		if err != nil {
+			t.Fatalf("NewNetwork error: %v", err)
+		}
+		if err := nt.AddLayer(asyncProcessThreshold+20, &activation.Sigmoid{}, nil); err != nil {
+			t.Fatalf("AddLayer error: %v", err)
+		}
+		nt.SetProps(Props{Seed: 5, Workers: workers})
+
+		samples := Samples{{Feature: Vector{0.1, 0.2, 0.3}, Target: Vector{1, 0}}}
+		if _, _, err := nt.trainEpoch(context.Background(), samples, nil); err != nil {
+			t.Fatalf("trainEpoch error: %v", err)
+		}
+		return nt
+	}
+
+	single := train(1)
+	many := train(4)
+	for i := range single.synaptics {
+		if !reflect.DeepEqual(single.synaptics[i].weight, many.synaptics[i].weight) {
+			t.Errorf("Layer %d: Expected identical weights for 1 and 4 workers", i)
+		}
+	}
+}
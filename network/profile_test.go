@@ -0,0 +1,149 @@
+package network
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/optimizer"
+	"github.com/harungurubudi/rolade/scheduler"
+)
+
+func TestSaveLoadResumesSchedule(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	nt.SetProps(Props{
+		Optimizer: optimizer.NewSGDWithLearningRate(0.1),
+		Scheduler: scheduler.NewStepDecay(2, 0.5),
+		MaxEpoch:  3,
+	})
+
+	samples, err := NewSamples([]Vector{{0, 1}, {1, 0}}, []Vector{{1}, {0}})
+	if err != nil {
+		t.Fatalf("Failed to create samples: %v", err)
+	}
+
+	if err := nt.Train(samples); err != nil {
+		t.Fatalf("Training failed: %v", err)
+	}
+
+	dir := t.TempDir()
+	if err := nt.Save(dir); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if loaded.epoch != 3 {
+		t.Errorf("Expected epoch 3, got %d", loaded.epoch)
+	}
+	if loaded.props.Scheduler == nil {
+		t.Fatalf("Expected scheduler to be restored")
+	}
+
+	// The next epoch is the fourth one, still in the second step of the schedule
+	loaded.schedule()
+	expected := 0.05
+	got := loaded.props.Optimizer.LearningRate()
+	if math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected learning rate %f, got %f", expected, got)
+	}
+}
+
+func TestSaveLoadKeepsLearnedPReLU(t *testing.T) {
+	nt, err := NewNetwork(2, 1, activation.NewPReLU(), nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+	if err := nt.AddLayer(4, &activation.Sigmoid{}, nil); err != nil {
+		t.Fatalf("AddLayer error: %v", err)
+	}
+
+	nt.SetProps(Props{
+		Optimizer: optimizer.NewSGDWithLearningRate(0.5),
+		MaxEpoch:  50,
+	})
+
+	samples, err := NewSamples([]Vector{{-1, -1}, {-1, 1}, {1, -1}, {1, 1}}, []Vector{{0}, {1}, {1}, {0}})
+	if err != nil {
+		t.Fatalf("Failed to create samples: %v", err)
+	}
+
+	if err := nt.Train(samples); err != nil {
+		t.Fatalf("Training failed: %v", err)
+	}
+
+	learned := nt.synaptics[0].activation.(*activation.PReLU).Alpha
+	if learned == 0.25 {
+		t.Errorf("Expected PReLU slope to be trained, still %f", learned)
+	}
+
+	dir := t.TempDir()
+	if err := nt.Save(dir); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	got := loaded.synaptics[0].activation.(*activation.PReLU).Alpha
+	if got != learned {
+		t.Errorf("Expected slope %f, got %f", learned, got)
+	}
+}
+
+func TestSaveLoadResumesPlateau(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	// A zero learning rate keeps the loss constant, so every observed epoch is a plateau
+	sgd := optimizer.NewSGD()
+	sgd.Alpha = 0
+	nt.SetProps(Props{
+		Optimizer: sgd,
+		Scheduler: scheduler.NewReduceOnPlateau(0.5, 1),
+		MaxEpoch:  3,
+		Callbacks: []ICallback{&BaseCallback{}},
+	})
+
+	samples, err := NewSamples([]Vector{{0, 1}, {1, 0}}, []Vector{{1}, {0}})
+	if err != nil {
+		t.Fatalf("Failed to create samples: %v", err)
+	}
+	if err := nt.Train(samples); err != nil {
+		t.Fatalf("Training failed: %v", err)
+	}
+
+	dir := t.TempDir()
+	if err := nt.Save(dir); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	loaded, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(nt.lossHistories, loaded.lossHistories) {
+		t.Errorf("Expected losses %v, got %v", nt.lossHistories, loaded.lossHistories)
+	}
+
+	// The resumed schedule observes the loss of the last saved epoch, like the original does
+	nt.schedule()
+	loaded.schedule()
+	expected := nt.props.Scheduler.(*scheduler.ReduceOnPlateau).Scale
+	got := loaded.props.Scheduler.(*scheduler.ReduceOnPlateau).Scale
+	if got != expected {
+		t.Errorf("Expected scale %f, got %f", expected, got)
+	}
+}
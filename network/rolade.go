@@ -1,17 +1,20 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"math"
+	"math/rand"
 	"os"
-	"sync"
+	"slices"
 
 	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/initializer"
 	"github.com/harungurubudi/rolade/loss"
 	"github.com/harungurubudi/rolade/model"
 	"github.com/harungurubudi/rolade/optimizer"
+	"github.com/harungurubudi/rolade/scheduler"
 )
 
 const asyncProcessThreshold = 128
@@ -19,57 +22,143 @@ const asyncProcessThreshold = 128
 type (
 	// Props defines the configuration for training the neural network,
 	// including the loss function, optimizer, error limit, and maximum number of epochs.
+	//
+	// WeightDecay enables decoupled weight decay (as in AdamW): on every update each weight
+	// is shrunk by LearningRate * WeightDecay * weight directly, instead of adding an L2 term
+	// to the gradient. Biases are only decayed when DecayBias is set.
+	//
+	// Scheduler, when set, overrides the optimizer's learning rate at the start of every epoch.
+	// The optimizer's learning rate at the first scheduled epoch is used as the base rate.
+	//
+	// ClipValue, ClipNorm and ClipGlobalNorm enable gradient clipping, applied to the merged
+	// gradients right before the optimizer step: each value is limited to [-ClipValue, ClipValue],
+	// each layer's L2 norm to ClipNorm, and the L2 norm across all layers to ClipGlobalNorm.
+	// Zero disables the respective clipping.
+	//
+	// ClassWeights optionally holds one weight per output. It scales the gradient of every output
+	// and its share of the reported loss, on top of the per-sample Sample.Weight.
+	//
+	// Regression marks the network as predicting continuous values, typically with an Identity
+	// output layer. Test then returns the raw output without a binary conclusion.
+	//
+	// Workers sets the number of goroutines the network uses to train and propagate in parallel,
+	// including the calling one. Zero uses runtime.GOMAXPROCS. It is a property of the machine
+	// rather than of the model, so it isn't saved. Seeded runs are reproducible for the same
+	// number of workers.
+	//
+	// BatchSize sets the number of samples per mini-batch; the optimizer takes one step per
+	// mini-batch. Zero uses the whole dataset as a single batch (full-batch gradient descent).
+	// Shuffle shuffles the samples at the start of every epoch, using the network's random source.
+	//
+	// Seed, when non-zero, seeds the network's random source, so that training with the same
+	// seed and data gives bit-identical weights. As the layers are already drawn by NewNetwork,
+	// setting a seed on a network that hasn't been updated or loaded draws them again from the
+	// seed.
+	//
+	// ErrLimit and Patience configure the default early stopping (see EarlyStopping). A Patience
+	// of zero disables stopping on a lack of improvement.
+	//
+	// Callbacks receive the training events, in order (see ICallback). When none are set,
+	// training uses an EarlyStopping built from ErrLimit and Patience, and a Logger. Setting
+	// Callbacks replaces both, so ErrLimit and Patience are then ignored; add NewEarlyStopping
+	// and NewLogger to the list to keep them. Callbacks hold code rather than configuration, so
+	// they aren't saved.
 	Props struct {
-		Loss      loss.ILoss
-		Optimizer optimizer.IOptimizer
-		ErrLimit  float64
-		MaxEpoch  int
-		Patience  int
+		Loss        loss.ILoss
+		Optimizer   optimizer.IOptimizer
+		Scheduler   scheduler.IScheduler
+		ErrLimit    float64
+		MaxEpoch    int
+		Patience    int
+		WeightDecay float64
+		DecayBias   bool
+
+		ClipValue      float64
+		ClipNorm       float64
+		ClipGlobalNorm float64
+
+		ClassWeights []float64
+		Regression   bool
+		BatchSize    int
+		Shuffle      bool
+		Seed         int64
+		Workers      int
+		Callbacks    []ICallback
 	}
 
 	// weight contains the weights and biases of a layer in the neural network.
 	weight struct {
 		weight [][]float64
 		bias   []float64
+		params []float64 // trainable parameters of the layer's activation, see activation.ILearnable
 	}
 
 	// synaptic defines a layer's structure, including the number of input/output neurons,
-	// weights, and activation function used during forward and backward passes.
+	// weights, and activation function used during forward and backward passes. initializer
+	// is kept so the layer can be drawn again, e.g. when AddLayer resizes it.
 	synaptic struct {
-		sourceSize int
-		targetSize int
-		weight     weight
-		activation activation.IActivation
+		sourceSize  int
+		targetSize  int
+		weight      weight
+		activation  activation.IActivation
+		initializer initializer.IInitializer
 	}
 
 	// Network represents a feedforward neural network composed of fully connected layers.
 	// It maintains the structure of the network (input/output sizes, layer weights, activations)
 	// and provides methods for forward propagation, backpropagation, and training.
+	//
+	// epoch counts every epoch trained so far, including the ones of previous runs of a loaded
+	// profile, and baseRate keeps the unscheduled learning rate. lossHistories holds the loss of
+	// every one of those epochs. All three are saved so a resumed run continues its learning-rate
+	// schedule.
+	//
+	// trained is set once any update was applied, or when the network was loaded, so its weights
+	// are kept even if training was interrupted before the first epoch was counted.
 	Network struct {
 		inputSize     int
 		outputSize    int
 		props         Props
 		synaptics     []synaptic
 		lossHistories []float64
+		epoch         int
+		baseRate      float64
+		clipStats     ClipStats
+		rand          *rand.Rand
+		workers       *pool
+		trained       bool
 	}
 
-	// deltas is a slice of weight updates used during backpropagation.
+	// evaluation collects the outputs of a set of samples with their targets and loss weights
+	// (Sample.Weight times ClassWeights, per output), ready to be passed to ILoss.Calculate.
+	evaluation struct {
+		predicted [][]float64
+		targets   [][]float64
+		weights   [][]float64
+	}
+
+	// deltas holds one weight-shaped value per layer. It carries the raw loss gradients
+	// produced by backpropagation as well as the updates the optimizer derives from them.
 	deltas []weight
 )
 
 // AddLayer - Add single hidden layer
-func (nt *Network) AddLayer(size int, activation activation.IActivation) error {
+//
+// The new layer becomes the output layer with the given activation, and the previous last
+// layer is redrawn to feed it. weightInit draws the new layer's weights and biases; when nil,
+// a default suited to the activation is used (see initializer.Default).
+func (nt *Network) AddLayer(size int, activation activation.IActivation, weightInit initializer.IInitializer) error {
 	netSize := len(nt.synaptics)
 	if netSize > 0 {
 		lastLayer := nt.synaptics[netSize-1]
-		sy, err := generateSynaptic(lastLayer.sourceSize, size, lastLayer.activation)
+		sy, err := generateSynaptic(lastLayer.sourceSize, size, lastLayer.activation, lastLayer.initializer, nt.rand)
 		if err != nil {
 			return fmt.Errorf("got error while add layer: %v", err)
 		}
 		nt.synaptics[netSize-1] = sy
 	}
 
-	sy, err := generateSynaptic(size, nt.outputSize, activation)
+	sy, err := generateSynaptic(size, nt.outputSize, activation, weightInit, nt.rand)
 	if err != nil {
 		return fmt.Errorf("got error while add layer: %v", err)
 	}
@@ -84,6 +173,10 @@ func (nt *Network) SetProps(props Props) {
 	}
 	if props.Optimizer != nil {
 		nt.props.Optimizer = props.Optimizer
+		nt.baseRate = 0
+	}
+	if props.Scheduler != nil {
+		nt.props.Scheduler = props.Scheduler
 	}
 	if props.ErrLimit != float64(0) {
 		nt.props.ErrLimit = props.ErrLimit
@@ -94,17 +187,94 @@ func (nt *Network) SetProps(props Props) {
 	if props.Patience != 0 {
 		nt.props.Patience = props.Patience
 	}
+	if props.WeightDecay != float64(0) {
+		nt.props.WeightDecay = props.WeightDecay
+	}
+	if props.DecayBias {
+		nt.props.DecayBias = props.DecayBias
+	}
+	if props.ClipValue != float64(0) {
+		nt.props.ClipValue = props.ClipValue
+	}
+	if props.ClipNorm != float64(0) {
+		nt.props.ClipNorm = props.ClipNorm
+	}
+	if props.ClipGlobalNorm != float64(0) {
+		nt.props.ClipGlobalNorm = props.ClipGlobalNorm
+	}
+	if props.ClassWeights != nil {
+		nt.props.ClassWeights = props.ClassWeights
+	}
+	if props.Regression {
+		nt.props.Regression = props.Regression
+	}
+	if props.BatchSize != 0 {
+		nt.props.BatchSize = props.BatchSize
+	}
+	if props.Shuffle {
+		nt.props.Shuffle = props.Shuffle
+	}
+	if props.Seed != 0 {
+		nt.props.Seed = props.Seed
+		nt.reseed()
+	}
+	if props.Workers != 0 && props.Workers != nt.props.Workers {
+		nt.props.Workers = props.Workers
+		nt.workers.close()
+		nt.workers = newPool(props.Workers)
+	}
+	if props.Callbacks != nil {
+		nt.props.Callbacks = props.Callbacks
+	}
+}
+
+// reseed restarts the network's random source from Props.Seed. Layers of a network that
+// hasn't been updated yet are drawn again, in order, so their weights depend on the seed only.
+func (nt *Network) reseed() {
+	nt.rand = newRand(nt.props.Seed)
+	if nt.trained {
+		return
+	}
+
+	for i, sy := range nt.synaptics {
+		redrawn, err := generateSynaptic(sy.sourceSize, sy.targetSize, sy.activation, sy.initializer, nt.rand)
+		if err != nil {
+			continue
+		}
+		nt.synaptics[i] = redrawn
+	}
 }
 
 // Test neural network
+//
+// It returns the raw output of the network together with a binary conclusion. Each output is
+// thresholded at 0.5, except for vector activations such as Softmax, whose outputs are class
+// probabilities summing to 1; there the conclusion marks the most probable class.
+//
+// For regression networks (see Props.Regression) there is nothing to threshold, so the
+// conclusion is nil.
 func (nt *Network) Test(input Vector) (Vector, []int, error) {
-	output, err := nt.forward(input)
+	_, output, err := nt.forward(input)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if nt.props.Regression {
+		return output[len(output)-1], nil, nil
+	}
+
+	return output[len(output)-1], nt.conclude(output[len(output)-1]), nil
+}
+
+// conclude turns an output vector into its binary conclusion: the most probable class for a
+// vector activation output layer, or every value thresholded at 0.5 otherwise.
+func (nt *Network) conclude(output Vector) []int {
+	if _, ok := nt.synaptics[len(nt.synaptics)-1].activation.(activation.IVectorActivation); ok {
+		return argmax(output)
+	}
+
 	var result []int
-	for _, item := range output[len(output)-1] {
+	for _, item := range output {
 		if item > 0.5 {
 			result = append(result, 1)
 		} else {
@@ -112,82 +282,93 @@ func (nt *Network) Test(input Vector) (Vector, []int, error) {
 		}
 	}
 
-	return output[len(output)-1], result, nil
+	return result
 }
 
 // forward performs a full forward pass through the network given an input vector.
-// It returns the output of each layer (including the final output layer) as a slice of Vector,
-// or an error if the input size does not match the expected input size of the network.
-func (nt *Network) forward(input Vector) (layerActivations []Vector, err error) {
+// It returns the weighted sums (pre-activations) and the output of each layer (including the
+// final output layer), or an error if the input size does not match the expected input size
+// of the network. The weighted sums are kept for backpropagation, where activation derivatives
+// are taken with respect to them.
+func (nt *Network) forward(input Vector) (layerSums []Vector, layerActivations []Vector, err error) {
+	layerSums = make([]Vector, 0, len(nt.synaptics))
 	layerActivations = make([]Vector, 0, len(nt.synaptics))
 	for i := 0; i < len(nt.synaptics); i++ {
-		input, err = nt.propagate(input, i)
+		var sum Vector
+		sum, input, err = nt.propagate(input, i)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		layerSums = append(layerSums, sum)
 		layerActivations = append(layerActivations, input)
 	}
 
-	return layerActivations, nil
+	return layerSums, layerActivations, nil
 }
 
 // propagate computes the output of a single layer (synaptic connection) in the network.
 // It applies the layer's weights, biases, and activation function to the input vector,
-// returning the resulting output vector or an error if the input size does not match
-// the expected number of source nodes.
-func (nt *Network) propagate(input Vector, synapticIndex int) (Vector, error) {
+// returning the weighted sums and the resulting output vector, or an error if the input
+// size does not match the expected number of source nodes.
+func (nt *Network) propagate(input Vector, synapticIndex int) (Vector, Vector, error) {
 	sy := nt.synaptics[synapticIndex]
 	if len(input) != sy.sourceSize {
-		return nil, fmt.Errorf("propagate input doesn't fit. Expect %d nodes, but got %d nodes", sy.sourceSize, len(input))
+		return nil, nil, fmt.Errorf("propagate input doesn't fit. Expect %d nodes, but got %d nodes", sy.sourceSize, len(input))
 	}
 
+	sums := make(Vector, sy.targetSize)
 	result := make(Vector, sy.targetSize)
 	weights := sy.weight.weight
 	biases := sy.weight.bias
 	activationFn := sy.activation
 
-	if sy.targetSize > asyncProcessThreshold {
-		var wg sync.WaitGroup
-		for j := range result {
-			wg.Add(1)
-			go func(j int) {
-				defer wg.Done()
-				result[j] = nt.computeNeuronActivation(input, weights, biases, j, activationFn)
-			}(j) // <- pass j explicitly
+	// Vector activations need the weighted sums of the whole layer, so the neurons only
+	// compute their sums and the activation is applied to the layer afterwards.
+	vectorFn, isVector := activationFn.(activation.IVectorActivation)
+	computeNeuron := func(j int) {
+		sums[j] = nt.computeNeuronSum(input, weights, biases, j)
+		if !isVector {
+			result[j] = activationFn.Activate(sums[j])
 		}
-		wg.Wait()
+	}
+
+	if sy.targetSize > asyncProcessThreshold {
+		nt.workers.spread(sy.targetSize, func(start int, end int) {
+			for j := start; j < end; j++ {
+				computeNeuron(j)
+			}
+		})
 	} else {
 		for j := range result {
-			result[j] = nt.computeNeuronActivation(input, weights, biases, j, activationFn)
+			computeNeuron(j)
 		}
 	}
 
-	return result, nil
+	if isVector {
+		result = vectorFn.ActivateVector(sums)
+	}
+
+	return sums, result, nil
 }
 
-// computeNeuronActivation calculates the output of a single neuron.
-//
-// It performs a weighted sum of inputs, adds the bias, and applies the activation function.
-//
-// Parameters:
-//   - input: the input feature vector
-//   - weights: 2D slice of weights for the layer
-//   - biases: bias terms for the layer
-//   - j: the index of the output neuron
-//   - act: the activation function
-//
-// Returns:
-//   - the activation output of neuron j
-func (nt *Network) computeNeuronActivation(input Vector, weights [][]float64, biases []float64, j int, act activation.IActivation) float64 {
+// computeNeuronSum calculates the weighted sum of inputs plus the bias of neuron j,
+// i.e. its value before the activation function is applied.
+func (nt *Network) computeNeuronSum(input Vector, weights [][]float64, biases []float64, j int) float64 {
 	var sum float64
 	for i := 0; i < len(input); i++ {
 		sum += weights[i][j] * input[i]
 	}
 	sum += biases[j]
-	return act.Activate(sum)
+	return sum
 }
 
-// Train runs the training process over the given input and target data using the configured
+// Train trains the network on samples. It is TrainContext with a context that is never done;
+// see TrainContext for details.
+func (nt *Network) Train(samples Samples) error {
+	return nt.TrainContext(context.Background(), samples)
+}
+
+// TrainContext runs the training process over the given input and target data using the configured
 // optimizer and loss function. It trains for a maximum number of epochs or until the loss
 // falls below the configured error limit (ErrLimit).
 //
@@ -195,184 +376,429 @@ func (nt *Network) computeNeuronActivation(input Vector, weights [][]float64, bi
 // applies optimizer updates, and logs progress at checkpoints.
 //
 // Returns an error if the input and target sizes do not match, or if an error occurs during training.
-func (nt *Network) Train(samples Samples) error {
-	var bestLoss = math.MaxFloat64
-	var epochsWithoutImprovement = 0
+// Every sample is checked against the network's input and output sizes before training starts.
+// Problems with individual samples are reported as *SampleError values joined with errors.Join,
+// so errors.Is(err, ErrFeatureSizeMismatch) and errors.As(err, &sampleErr) work on the result.
+//
+// ctx is checked before every epoch and every mini-batch. Once it is done, training stops and
+// ctx.Err() is returned. The network keeps the weights of the last applied optimizer step; a
+// step is never interrupted half-way.
+func (nt *Network) TrainContext(ctx context.Context, samples Samples) error {
+	defer nt.logClipStats()
+
+	if err := nt.validate(samples); err != nil {
+		return err
+	}
+
+	callbacks := nt.callbacks()
+	if _, err := notify(callbacks, func(cb ICallback) error { return cb.OnTrainBegin(nt) }); err != nil {
+		return fmt.Errorf("got error while starting training: %v", err)
+	}
+
+	// loss is reported to OnTrainEnd; it is the loss of the last finished epoch.
+	var loss float64
+	if len(nt.lossHistories) > 0 {
+		loss = nt.lossHistories[len(nt.lossHistories)-1]
+	}
 	for epoch := 0; epoch < nt.props.MaxEpoch; epoch++ {
-		errMean, err := nt.trainEpoch(samples)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		nt.schedule()
+
+		// The default callbacks don't observe batches, so only the caller's are notified
+		eval, stop, err := nt.trainEpoch(ctx, samples, nt.props.Callbacks)
 		if err != nil {
 			return err
 		}
+		if stop {
+			break
+		}
+		nt.epoch++
 
-		loss := nt.props.Loss.Calculate(errMean)
+		loss = nt.props.Loss.Calculate(eval.predicted, eval.targets, eval.weights)
 		nt.lossHistories = append(nt.lossHistories, loss)
 
-		if nt.props.MaxEpoch > 20 && epoch%(nt.props.MaxEpoch/20) == 0 {
-			log.Printf("Training in epoch %d with loss: %f\n", epoch, loss)
-		}
-
-		// Implements early stopping logic to terminate training when no improvement occurs.
-		//
-		// This mechanism tracks the best loss value seen so far and counts how many consecutive epochs
-		// have passed without an improvement (i.e., a lower loss value). If the number of such epochs
-		// exceeds the configured `Patience`, training stops early to prevent overfitting or wasted computation.
-		//
-		// Additionally, if the loss drops below the predefined error threshold (`ErrLimit`), training
-		// also stops immediately.
-		//
-		// - bestLoss stores the lowest loss observed so far.
-		// - epochsWithoutImprovement counts how many epochs have passed since the last best loss.
-		// - Patience defines the maximum tolerated epochs without improvement before stopping.
-		//
-		// This strategy improves training efficiency and avoids overfitting by halting when further
-		// progress is unlikely.
-		if loss < bestLoss {
-			bestLoss = loss
-			epochsWithoutImprovement = 0
-		} else {
-			epochsWithoutImprovement++
+		metrics := nt.metrics(eval)
+		stop, err = notify(callbacks, func(cb ICallback) error { return cb.OnEpochEnd(nt, epoch, loss, metrics) })
+		if err != nil {
+			return fmt.Errorf("got error while ending epoch %d: %v", epoch, err)
+		}
+		if stop {
+			break
 		}
+	}
+
+	if _, err := notify(callbacks, func(cb ICallback) error { return cb.OnTrainEnd(nt, loss) }); err != nil {
+		return fmt.Errorf("got error while ending training: %v", err)
+	}
+	return nil
+}
+
+// metrics computes the Metrics reported to OnEpochEnd from the outputs of an epoch.
+func (nt *Network) metrics(eval evaluation) Metrics {
+	metrics := Metrics{"learning_rate": nt.props.Optimizer.LearningRate()}
+	if nt.props.Regression || len(eval.predicted) == 0 {
+		return metrics
+	}
 
-		if loss <= nt.props.ErrLimit {
-			log.Printf("Stopping early: loss (%f) is below threshold", loss)
-			return nil
+	var correct int
+	for i := range eval.predicted {
+		if slices.Equal(nt.conclude(eval.predicted[i]), nt.conclude(eval.targets[i])) {
+			correct++
 		}
+	}
+	metrics["accuracy"] = float64(correct) / float64(len(eval.predicted))
+	return metrics
+}
 
-		if epochsWithoutImprovement >= nt.props.Patience {
-			log.Printf("Stopping early: no improvement in last %d epochs", nt.props.Patience)
-			return nil
+// validate checks every sample against the shape of the network before training starts, so a
+// mis-sized sample is reported up front instead of failing somewhere in an epoch. All problems
+// are reported at once, joined with errors.Join, each as a *SampleError.
+func (nt *Network) validate(samples Samples) error {
+	var errs []error
+	for i, sample := range samples {
+		if len(sample.Feature) != nt.inputSize {
+			errs = append(errs, &SampleError{
+				Index: i,
+				Err:   fmt.Errorf("%w: expected %d, got %d", ErrFeatureSizeMismatch, nt.inputSize, len(sample.Feature)),
+			})
+		}
+		if len(sample.Target) != nt.outputSize {
+			errs = append(errs, &SampleError{
+				Index: i,
+				Err:   fmt.Errorf("%w: expected %d, got %d", ErrTargetSizeMismatch, nt.outputSize, len(sample.Target)),
+			})
+		}
+		if sample.Weight < 0 {
+			errs = append(errs, &SampleError{
+				Index: i,
+				Err:   fmt.Errorf("%w: got %f", ErrNegativeWeight, sample.Weight),
+			})
 		}
 	}
-	log.Printf("Training completed with final loss: %f", bestLoss)
-	return nil
+
+	if len(nt.props.ClassWeights) > 0 && len(nt.props.ClassWeights) != nt.outputSize {
+		errs = append(errs, fmt.Errorf("%w: got %d class weights for %d targets", ErrClassWeightsMismatch, len(nt.props.ClassWeights), nt.outputSize))
+	}
+
+	return errors.Join(errs...)
 }
 
-// trainEpoch performs one full training epoch over the provided dataset.
+// schedule sets the optimizer's learning rate for the upcoming epoch using the configured
+// scheduler, if any.
 //
-// The dataset is split into batches which are processed in parallel using goroutines.
-// Each batch goes through forward and backward propagation, computing local weight deltas and errors.
-// After all batches are processed, the resulting deltas are merged and applied to the network weights.
-// The function returns a vector of error values (one per sample) or an error if the training fails.
+// The base rate is read from the optimizer the first time it is scheduled and kept afterwards,
+// because the optimizer's own rate is overwritten every epoch.
+func (nt *Network) schedule() {
+	if nt.props.Scheduler == nil {
+		return
+	}
+
+	if nt.baseRate == 0 {
+		nt.baseRate = nt.props.Optimizer.LearningRate()
+	}
+
+	rate := nt.props.Scheduler.Rate(nt.epoch, nt.baseRate, nt.lossHistories)
+	nt.props.Optimizer.SetLearningRate(rate)
+}
+
+// trainEpoch runs one training epoch over the provided dataset.
 //
-// Note:
-// - Parallelism is limited to a fixed number of goroutines (maxGoroutines).
-// - mergeDeltas ensures stability by averaging gradients across batches.
+// The samples are shuffled if Props.Shuffle is set, and split into mini-batches of
+// Props.BatchSize samples. For every mini-batch the gradients are computed and the optimizer
+// takes one step, so later mini-batches see the weights updated by earlier ones.
 //
 // Parameters:
+//   - ctx: checked before every mini-batch; its error is returned once it is done.
 //   - samples: the full set of training samples for this epoch.
+//   - callbacks: notified with OnBatchEnd after every mini-batch. When empty, the batch loss
+//     isn't computed.
 //
 // Returns:
-//   - errMean: a vector of per-sample error values.
+//   - eval: the outputs, targets and loss weights of every sample, for loss reporting. Each
+//     output is the one computed for the sample's mini-batch, before its update.
+//   - stop: whether a callback asked to stop training. The updates of the batches done so far
+//     are kept, but the epoch is left unfinished.
 //   - err: any error that occurred during batch training.
-func (nt *Network) trainEpoch(samples Samples) (errMean Vector, err error) {
-	// TODO: make this constants dynamic
-	const maxGoroutines = 10
+func (nt *Network) trainEpoch(ctx context.Context, samples Samples, callbacks []ICallback) (eval evaluation, stop bool, err error) {
+	// order holds the dataset index of every sample in training order, so errors can
+	// point to the sample in the caller's dataset even after shuffling.
+	order := make([]int, samples.Len())
+	for i := range order {
+		order[i] = i
+	}
+	if nt.props.Shuffle {
+		order = nt.rand.Perm(samples.Len())
+	}
 
-	batchSize := samples.Len() / maxGoroutines
-	if batchSize == 0 {
-		batchSize = 1
+	batchSize := nt.props.BatchSize
+	if batchSize <= 0 {
+		batchSize = samples.Len()
 	}
 
-	batches := samples.Split(batchSize)
+	for batch, start := 0, 0; start < len(order); batch, start = batch+1, start+batchSize {
+		if err := ctx.Err(); err != nil {
+			return eval, false, err
+		}
+
+		indices := order[start:min(start+batchSize, len(order))]
+		batchSamples := make(Samples, len(indices))
+		for i, index := range indices {
+			batchSamples[i] = samples[index]
+		}
 
+		batchEval, grads, err := nt.computeGradients(batchSamples, indices)
+		if err != nil {
+			return eval, false, err
+		}
+		nt.step(grads)
+
+		eval.predicted = append(eval.predicted, batchEval.predicted...)
+		eval.targets = append(eval.targets, batchEval.targets...)
+		eval.weights = append(eval.weights, batchEval.weights...)
+
+		// The batch loss is only worth computing when someone listens
+		if len(callbacks) == 0 {
+			continue
+		}
+		batchLoss := nt.props.Loss.Calculate(batchEval.predicted, batchEval.targets, batchEval.weights)
+		stop, err := notify(callbacks, func(cb ICallback) error { return cb.OnBatchEnd(nt, batch, batchLoss) })
+		if err != nil {
+			return eval, false, fmt.Errorf("got error while ending batch %d: %v", batch, err)
+		}
+		if stop {
+			return eval, true, nil
+		}
+	}
+
+	return eval, false, nil
+}
+
+// computeGradients computes the gradients of a mini-batch without touching the weights;
+// applying them is left to step.
+//
+// The mini-batch is split into one chunk per worker (see Props.Workers), and the chunks are
+// processed in parallel on the network's worker pool. Each chunk goes through forward and
+// backward propagation, computing local gradients and outputs. After all chunks are processed,
+// their gradients are merged into the average gradient over every sample of the mini-batch.
+//
+// Note:
+//   - mergeDeltas weights each chunk by the total weight of its samples, so the result is the
+//     true weighted per-sample average.
+//
+// Parameters:
+//   - samples: the samples of the mini-batch.
+//   - indices: the dataset index of every sample, used to report errors.
+//
+// Returns:
+//   - eval: the outputs, targets and loss weights of every sample, for loss reporting.
+//   - grads: the gradients averaged over all samples of the mini-batch.
+//   - err: the errors of every failed sample, joined with errors.Join.
+func (nt *Network) computeGradients(samples Samples, indices []int) (eval evaluation, grads deltas, err error) {
+	chunkSize := (samples.Len() + nt.workers.size - 1) / nt.workers.size
+	if chunkSize == 0 {
+		chunkSize = 1
+	}
+
+	batches := samples.Split(chunkSize)
+
+	// Every batch writes its results to its own slot, so they are merged in batch order
+	// no matter in which order the workers finish. This keeps seeded runs reproducible.
 	var (
-		wg        sync.WaitGroup
-		mutex     sync.Mutex
-		allErrs   []float64
-		allDeltas []deltas
+		evals      = make([]evaluation, len(batches))
+		batchGrads = make([]deltas, len(batches))
+		totals     = make([]float64, len(batches))
+		errs       = make([][]error, len(batches))
 	)
 
-	for _, batch := range batches {
-		wg.Add(1)
-		go func(batch Samples) {
-			defer wg.Done()
+	nt.workers.parallel(len(batches), func(i int) {
+		batch := batches[i]
+		batchEval, grads, err := nt.trainBatch(batch)
+		if err != nil {
+			errs[i] = reindex(err, indices[i*chunkSize:])
+			return
+		}
 
-			batchErr, batchDelta, err := nt.trainBatch(batch)
-			if err != nil {
-				return // Could log or collect failed batch info here
-			}
+		var total float64
+		for _, sample := range batch {
+			total += sample.lossWeight()
+		}
 
-			mutex.Lock()
-			allErrs = append(allErrs, batchErr...)
-			allDeltas = append(allDeltas, batchDelta)
-			mutex.Unlock()
-		}(batch)
+		evals[i] = batchEval
+		batchGrads[i] = grads
+		totals[i] = total
+	})
+
+	var failures []error
+	for i := range batches {
+		failures = append(failures, errs[i]...)
+	}
+	if len(failures) > 0 {
+		return eval, grads, errors.Join(failures...)
+	}
+
+	for i := range batches {
+		eval.predicted = append(eval.predicted, evals[i].predicted...)
+		eval.targets = append(eval.targets, evals[i].targets...)
+		eval.weights = append(eval.weights, evals[i].weights...)
 	}
 
-	wg.Wait()
+	return eval, mergeDeltas(batchGrads, totals), nil
+}
 
-	finalDelta := mergeDeltas(allDeltas)
-	nt.updateWeight(finalDelta)
+// reindex splits an error returned by trainBatch into the errors of the individual samples,
+// replacing their batch-local indices with the dataset indices given by indices.
+func reindex(err error, indices []int) []error {
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
 
-	return allErrs, nil
+	for _, e := range errs {
+		var sampleErr *SampleError
+		if errors.As(e, &sampleErr) && sampleErr.Index < len(indices) {
+			sampleErr.Index = indices[sampleErr.Index]
+		}
+	}
+	return errs
 }
 
 // trainBatch performs training on a single batch of samples.
 //
 // For each sample in the batch, it performs:
 //   - Forward propagation to compute the output.
-//   - Error and gradient calculation at the output layer.
-//   - Backward propagation to compute weight deltas.
-//   - Error accumulation for loss reporting.
+//   - Gradient calculation at the output layer.
+//   - Backward propagation to compute weight gradients.
+//   - Output accumulation for loss reporting.
 //
-// All deltas from individual samples are collected and merged into a single delta
-// which is returned for later weight updates (outside this function).
+// The gradients of the individual samples are averaged, weighted by Sample.Weight, into a single
+// set of raw gradients, which is returned for the optimizer step (outside this function).
 //
 // Parameters:
 //   - batch: a slice of samples representing a mini-batch.
 //
 // Returns:
-//   - errMean: slice of mean errors for each sample in the batch.
-//   - delta: merged weight/bias deltas to be applied later.
-//   - err: error if training fails at any point in the batch.
-func (nt *Network) trainBatch(batch Samples) (errMean []float64, delta deltas, err error) {
-	var returnTrainingError = func(index int, err error) error {
-		return fmt.Errorf("got an error while train with data %d: %v", index, err)
-	}
-
-	var deltasInBatch []deltas
+//   - eval: the outputs, targets and loss weights of each sample in the batch.
+//   - grads: weight/bias gradients averaged over the batch.
+//   - err: the errors of every failed sample, each a *SampleError with the sample's index in
+//     the batch, joined with errors.Join.
+func (nt *Network) trainBatch(batch Samples) (eval evaluation, grads deltas, err error) {
+	var (
+		gradsInBatch  []deltas
+		sampleWeights []float64
+		errs          []error
+	)
 	for i, sample := range batch {
-		outputs, err := nt.forward(sample.Feature)
+		sums, outputs, err := nt.forward(sample.Feature)
 		if err != nil {
-			return errMean, delta, returnTrainingError(i, err)
+			errs = append(errs, &SampleError{Index: i, Err: err})
+			continue
 		}
 
 		// Build node layers for backpropagation (input + hidden layers)
 		nodes := append([]Vector{sample.Feature}, outputs[:len(outputs)-1]...)
 
-		// Compute output layer error and gradient
-		targetError := make(Vector, len(sample.Target))
-		grad := make(Vector, len(sample.Target))
-		for j, expected := range sample.Target {
-			actual := outputs[len(outputs)-1][j]
-			targetError[j] = expected - actual
-			grad[j] = targetError[j] * nt.synaptics[len(nt.synaptics)-1].activation.Derivate(actual)
-		}
+		// Compute output layer gradient
+		output := outputs[len(outputs)-1]
+		grad, params := nt.outputGradient(sums[len(sums)-1], output, sample.Target)
 
-		// Calculate deltas for backpropagation
-		d, err := nt.calculateDelta(grad, nodes)
+		// Calculate gradients for backpropagation
+		d, err := nt.calculateDelta(grad, nodes, sums)
 		if err != nil {
-			return errMean, delta, returnTrainingError(i, err)
+			errs = append(errs, &SampleError{Index: i, Err: err})
+			continue
 		}
-		deltasInBatch = append(deltasInBatch, d)
+		d[len(d)-1].params = params
+		gradsInBatch = append(gradsInBatch, d)
+		sampleWeights = append(sampleWeights, sample.lossWeight())
+
+		// Keep the output for loss reporting
+		eval.predicted = append(eval.predicted, output)
+		eval.targets = append(eval.targets, sample.Target)
+		eval.weights = append(eval.weights, nt.lossWeights(sample))
+	}
 
-		// Compute and accumulate per-sample error
-		tmpTErr, err := mean(targetError)
-		if err != nil {
-			return errMean, delta, returnTrainingError(i, err)
+	if err := errors.Join(errs...); err != nil {
+		return eval, grads, err
+	}
+
+	grads = mergeDeltas(gradsInBatch, sampleWeights)
+	return eval, grads, nil
+}
+
+// outputGradient computes the gradient of the loss with respect to the weighted sums of the
+// output layer for a single sample.
+//
+// If the loss has a fused gradient for the output activation (e.g. cross-entropy after softmax),
+// it is used directly. Otherwise the loss gradient with respect to the outputs is passed back
+// through the output activation. Either way every output is weighted by its class weight.
+//
+// The second return value holds the gradients of the output activation's own parameters,
+// if it has any (see activation.ILearnable).
+func (nt *Network) outputGradient(sum Vector, output Vector, target Vector) (Vector, []float64) {
+	act := nt.synaptics[len(nt.synaptics)-1].activation
+	if fused, ok := nt.props.Loss.(loss.IFusedLoss); ok {
+		if grad, ok := fused.FusedGradient(act, output, target, nt.props.ClassWeights); ok {
+			return grad, nil
+		}
+	}
+
+	grad := nt.props.Loss.Gradient(output, target)
+	for j := range nt.props.ClassWeights {
+		grad[j] *= nt.props.ClassWeights[j]
+	}
+	params := paramGradient(act, sum, output, grad)
+	return activationBackward(act, sum, output, grad), params
+}
+
+// lossWeights returns the weight of every output of a sample in the reported loss: the sample
+// weight times the class weight of the output.
+func (nt *Network) lossWeights(sample Sample) []float64 {
+	weights := make([]float64, len(sample.Target))
+	for j := range weights {
+		weights[j] = sample.lossWeight()
+		if len(nt.props.ClassWeights) > 0 {
+			weights[j] *= nt.props.ClassWeights[j]
 		}
-		errMean = append(errMean, tmpTErr)
 	}
+	return weights
+}
+
+// activationBackward turns the gradient with respect to the outputs of a layer into the
+// gradient with respect to its weighted sums, given the layer's activation, weighted sums
+// and outputs.
+func activationBackward(act activation.IActivation, sum Vector, output Vector, grad Vector) Vector {
+	if vectorFn, ok := act.(activation.IVectorActivation); ok {
+		return vectorFn.BackwardVector(sum, output, grad)
+	}
+
+	for j := range grad {
+		grad[j] *= act.Derivate(sum[j], output[j])
+	}
+	return grad
+}
 
-	delta = mergeDeltas(deltasInBatch)
-	return errMean, delta, nil
+// paramGradient returns the gradients of a layer's activation parameters, given the layer's
+// weighted sums, outputs and the gradient with respect to the outputs. It is nil for
+// activations without parameters.
+func paramGradient(act activation.IActivation, sum Vector, output Vector, grad Vector) []float64 {
+	if learnable, ok := act.(activation.ILearnable); ok {
+		return learnable.ParamGradient(sum, output, grad)
+	}
+	return nil
 }
 
-// MergeDeltas combines multiple deltas (from different batches)
-// into a single averaged delta to be applied once.
+// MergeDeltas combines multiple deltas (from different samples or batches)
+// into a single weighted average to be applied once.
+//
+// weights gives the weight of each entry in all, typically the total weight of the samples
+// a batch gradient was averaged over. A nil weights slice weighs every entry equally. Sample
+// weights are positive (see validate), so the total is only zero when all is empty.
 //
 // Each delta corresponds to a layer (len = numLayers)
-func mergeDeltas(all []deltas) deltas {
+func mergeDeltas(all []deltas, weights []float64) deltas {
 	if len(all) == 0 {
 		return nil
 	}
@@ -383,6 +809,7 @@ func mergeDeltas(all []deltas) deltas {
 		merged[i] = weight{
 			weight: make([][]float64, len(all[0][i].weight)),
 			bias:   make([]float64, len(all[0][i].bias)),
+			params: make([]float64, len(all[0][i].params)),
 		}
 		for j := range all[0][i].weight {
 			merged[i].weight[j] = make([]float64, len(all[0][i].weight[j]))
@@ -390,21 +817,33 @@ func mergeDeltas(all []deltas) deltas {
 	}
 
 	// Accumulate
-	for _, d := range all {
+	var n float64
+	for idx, d := range all {
+		factor := float64(1)
+		if weights != nil {
+			factor = weights[idx]
+		}
+		n += factor
+
 		for i, w := range d {
 			for j := range w.weight {
 				for k := range w.weight[j] {
-					merged[i].weight[j][k] += w.weight[j][k]
+					merged[i].weight[j][k] += factor * w.weight[j][k]
 				}
 			}
 			for j := range w.bias {
-				merged[i].bias[j] += w.bias[j]
+				merged[i].bias[j] += factor * w.bias[j]
+			}
+			for j := range w.params {
+				merged[i].params[j] += factor * w.params[j]
 			}
 		}
 	}
 
 	// Average
-	n := float64(len(all))
+	if n == 0 {
+		return merged
+	}
 	for i := range merged {
 		for j := range merged[i].weight {
 			for k := range merged[i].weight[j] {
@@ -414,6 +853,9 @@ func mergeDeltas(all []deltas) deltas {
 		for j := range merged[i].bias {
 			merged[i].bias[j] /= n
 		}
+		for j := range merged[i].params {
+			merged[i].params[j] /= n
+		}
 	}
 
 	return merged
@@ -428,48 +870,73 @@ func mergeDeltas(all []deltas) deltas {
 //   - grad: the gradient of the loss with respect to the final output layer
 //   - nodes: a slice of FeatureVectors representing the activation at each layer,
 //     including the original input and all hidden layers (but excluding output)
+//   - sums: the weighted sums of every layer, as returned by forward; sums[i-1] are the
+//     pre-activations of nodes[i]
 //
 // Returns:
 //   - a slice of weight updates (deltas), one per layer
 //   - an error if backpropagation fails at any layer
-func (nt *Network) calculateDelta(prevGradient Vector, nodes []Vector) (deltas, error) {
+func (nt *Network) calculateDelta(prevGradient Vector, nodes []Vector, sums []Vector) (deltas, error) {
 	deltaList := make([]weight, len(nodes))
 	for i := len(nodes) - 1; i >= 0; i-- {
 		// Backpropagate current layer, accumulate delta and update gradient for previous layer
-		outputGradient, d, err := nt.backPropagate(prevGradient, nodes[i], nt.synaptics[i])
+		outputGradient, d, err := nt.backPropagate(prevGradient, nodes[i], i)
 		if err != nil {
 			return nil, err
 		}
-		deltaList[i] = d
+		deltaList[i].weight, deltaList[i].bias = d.weight, d.bias
+
+		// nodes[i] is the output of the previous layer, so the gradient goes back through that
+		// layer's activation. The first layer is fed by the input, which has no activation.
+		if i > 0 {
+			act := nt.synaptics[i-1].activation
+			deltaList[i-1].params = paramGradient(act, sums[i-1], nodes[i], outputGradient)
+			outputGradient = activationBackward(act, sums[i-1], nodes[i], outputGradient)
+		}
 		prevGradient = Vector(outputGradient)
 	}
 
 	return deltas(deltaList), nil
 }
 
-// backPropagate computes the gradient for the previous layer and the weight updates (deltas)
-// for the current synaptic layer. It applies the derivative of the activation function
-// and uses the optimizer to determine the magnitude of weight updates.
+// backPropagate computes the gradient with respect to the outputs of the previous layer and
+// the gradients of the loss with respect to the weights and biases of the current synaptic
+// layer. The derivative of the previous layer's activation is applied by calculateDelta.
+// The optimizer is not involved here; it is stepped once per update on the merged gradients
+// (see step).
 //
-// Returns the new gradient, the calculated weight deltas, or an error if the process fails.
-func (nt *Network) backPropagate(prevGradient Vector, node Vector, sy synaptic) (Vector, weight, error) {
-	var weightDelta [][]float64
+// Returns the new gradient, the calculated weight gradients, or an error if the process fails.
+func (nt *Network) backPropagate(prevGradient Vector, node Vector, layer int) (Vector, weight, error) {
+	sy := nt.synaptics[layer]
+	weightDelta := make([][]float64, len(node))
 	outputGradient := make(Vector, len(node))
 
-	for i := range node {
+	computeNode := func(i int) {
 		var tErrLocalSum float64
-		var weightDeltaLocal []float64
+		weightDeltaLocal := make([]float64, len(prevGradient))
 		for j := range prevGradient {
 			tErrLocalSum += (prevGradient[j] * sy.weight.weight[i][j])
-			weightDeltaLocal = append(weightDeltaLocal, nt.props.Optimizer.CalculateDelta(node[i]*prevGradient[j]))
+			weightDeltaLocal[j] = node[i] * prevGradient[j]
+		}
+		outputGradient[i] = tErrLocalSum
+		weightDelta[i] = weightDeltaLocal
+	}
+
+	if len(node) > asyncProcessThreshold {
+		nt.workers.spread(len(node), func(start int, end int) {
+			for i := start; i < end; i++ {
+				computeNode(i)
+			}
+		})
+	} else {
+		for i := range node {
+			computeNode(i)
 		}
-		outputGradient[i] = tErrLocalSum * sy.activation.Derivate(node[i])
-		weightDelta = append(weightDelta, weightDeltaLocal)
 	}
 
 	var biasDelta []float64
 	for j := range prevGradient {
-		biasDelta = append(biasDelta, nt.props.Optimizer.CalculateDelta(prevGradient[j]))
+		biasDelta = append(biasDelta, prevGradient[j])
 	}
 
 	dSet := weight{
@@ -480,6 +947,22 @@ func (nt *Network) backPropagate(prevGradient Vector, node Vector, sy synaptic)
 	return outputGradient, dSet, nil
 }
 
+// step performs a single optimizer update. It clips the averaged gradients of every layer,
+// hands them to the optimizer and applies the deltas it returns through updateWeight.
+//
+// Calling the optimizer exactly once per update, on the gradient of the whole batch, keeps
+// its per-parameter state (momentum, moment estimates) consistent and free of data races.
+func (nt *Network) step(grads deltas) {
+	if len(grads) == 0 {
+		return
+	}
+
+	nt.clip(grads)
+	d := fromModelWeights(nt.props.Optimizer.Step(toModelWeights(grads)))
+	nt.updateWeight(d)
+	nt.trained = true
+}
+
 // updateWeight applies the provided deltas to the synaptic weights and biases of the network.
 //
 // Each delta contains the computed changes for a specific layer's weight matrix and bias vector,
@@ -494,15 +977,9 @@ func (nt *Network) updateWeight(d deltas) {
 
 	// Parallel update if network is large enough
 	if len(d[0].bias) >= asyncProcessThreshold {
-		var wg sync.WaitGroup
-		for i := range d {
-			wg.Add(1)
-			go func(i int) {
-				defer wg.Done()
-				nt.applyDelta(i, d[i])
-			}(i)
-		}
-		wg.Wait()
+		nt.workers.parallel(len(d), func(i int) {
+			nt.applyDelta(i, d[i])
+		})
 		return
 	}
 
@@ -512,16 +989,36 @@ func (nt *Network) updateWeight(d deltas) {
 	}
 }
 
-// applyDelta applies a single delta to the specified layer
+// applyDelta applies a single delta to the specified layer.
+//
+// When WeightDecay is configured, the parameters are also shrunk towards zero in proportion
+// to their current value and the optimizer's learning rate. This is decoupled from the
+// gradient, so adaptive optimizers don't rescale it.
 func (nt *Network) applyDelta(i int, delta weight) {
 	layer := &nt.synaptics[i].weight
+	decay := nt.props.WeightDecay * nt.props.Optimizer.LearningRate()
+
+	var biasDecay float64
+	if nt.props.DecayBias {
+		biasDecay = decay
+	}
+
 	for j := range delta.weight {
 		for k := range delta.weight[j] {
-			layer.weight[j][k] += delta.weight[j][k]
+			layer.weight[j][k] += delta.weight[j][k] - decay*layer.weight[j][k]
 		}
 	}
 	for j := range delta.bias {
-		layer.bias[j] += delta.bias[j]
+		layer.bias[j] += delta.bias[j] - biasDecay*layer.bias[j]
+	}
+
+	// Activation parameters are not decayed
+	if learnable, ok := nt.synaptics[i].activation.(activation.ILearnable); ok && len(delta.params) > 0 {
+		params := learnable.Params()
+		for j := range delta.params {
+			params[j] += delta.params[j]
+		}
+		learnable.SetParams(params)
 	}
 }
 
@@ -539,6 +1036,10 @@ func (nt *Network) Save(path string) (err error) {
 		if err != nil {
 			return fmt.Errorf("got error while marshalling activation: %v", err)
 		}
+		ijs, err := json.Marshal(sySource.initializer)
+		if err != nil {
+			return fmt.Errorf("got error while marshalling initializer: %v", err)
+		}
 		sy = append(sy, model.Synaptic{
 			SourceSize: sySource.sourceSize,
 			TargetSize: sySource.targetSize,
@@ -550,6 +1051,10 @@ func (nt *Network) Save(path string) (err error) {
 				Name:  sySource.activation.CallMe(),
 				Props: string(ajs),
 			},
+			Initializer: model.Attr{
+				Name:  sySource.initializer.CallMe(),
+				Props: string(ijs),
+			},
 		})
 	}
 
@@ -563,9 +1068,24 @@ func (nt *Network) Save(path string) (err error) {
 		return fmt.Errorf("got error while marshalling optimizer: %v", err)
 	}
 
+	var schedulerAttr model.Attr
+	if nt.props.Scheduler != nil {
+		sjs, err := json.Marshal(nt.props.Scheduler)
+		if err != nil {
+			return fmt.Errorf("got error while marshalling scheduler: %v", err)
+		}
+		schedulerAttr = model.Attr{
+			Name:  nt.props.Scheduler.CallMe(),
+			Props: string(sjs),
+		}
+	}
+
 	r := model.Network{
 		InputSize:  nt.inputSize,
 		OutputSize: nt.outputSize,
+		Epoch:      nt.epoch,
+		BaseRate:   nt.baseRate,
+		Losses:     nt.lossHistories,
 		Synaptics:  sy,
 		Props: model.Props{
 			Loss: model.Attr{
@@ -576,9 +1096,20 @@ func (nt *Network) Save(path string) (err error) {
 				Name:  nt.props.Optimizer.CallMe(),
 				Props: string(ojs),
 			},
-			ErrLimit: nt.props.ErrLimit,
-			MaxEpoch: nt.props.MaxEpoch,
-			Patience: nt.props.Patience,
+			Scheduler:      schedulerAttr,
+			ErrLimit:       nt.props.ErrLimit,
+			MaxEpoch:       nt.props.MaxEpoch,
+			Patience:       nt.props.Patience,
+			WeightDecay:    nt.props.WeightDecay,
+			DecayBias:      nt.props.DecayBias,
+			ClipValue:      nt.props.ClipValue,
+			ClipNorm:       nt.props.ClipNorm,
+			ClipGlobalNorm: nt.props.ClipGlobalNorm,
+			ClassWeights:   nt.props.ClassWeights,
+			Regression:     nt.props.Regression,
+			BatchSize:      nt.props.BatchSize,
+			Shuffle:        nt.props.Shuffle,
+			Seed:           nt.props.Seed,
 		},
 	}
 
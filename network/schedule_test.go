@@ -0,0 +1,62 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/optimizer"
+)
+
+// cloneWeight returns a deep copy of w, as layers are updated in place.
+func cloneWeight(w weight) weight {
+	c := weight{
+		weight: make([][]float64, len(w.weight)),
+		bias:   append([]float64(nil), w.bias...),
+		params: append([]float64(nil), w.params...),
+	}
+	for j := range w.weight {
+		c.weight[j] = append([]float64(nil), w.weight[j]...)
+	}
+	return c
+}
+
+// zeroScheduler decays the learning rate to zero right away.
+type zeroScheduler struct{}
+
+func (s *zeroScheduler) Rate(_ int, _ float64, _ []float64) float64 {
+	return 0
+}
+
+func (s *zeroScheduler) CallMe() string {
+	return "zero"
+}
+
+func TestTrainZeroLearningRateKeepsWeights(t *testing.T) {
+	optimizers := []optimizer.IOptimizer{
+		optimizer.NewSGD(),
+		optimizer.NewAdam(),
+		optimizer.NewRMSProp(),
+		optimizer.NewAdagrad(),
+	}
+
+	for _, opt := range optimizers {
+		nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+		if err != nil {
+			t.Fatalf("NewNetwork error: %v", err)
+		}
+		nt.SetProps(Props{Optimizer: opt, Scheduler: &zeroScheduler{}, MaxEpoch: 3, Callbacks: []ICallback{&BaseCallback{}}})
+
+		before := cloneWeight(nt.synaptics[0].weight)
+		if err := nt.Train(getContextTestSamples()); err != nil {
+			t.Fatalf("Train error: %v", err)
+		}
+
+		if opt.LearningRate() != 0 {
+			t.Errorf("%s: Expected learning rate 0, got %f", opt.CallMe(), opt.LearningRate())
+		}
+		if !reflect.DeepEqual(before, nt.synaptics[0].weight) {
+			t.Errorf("%s: Expected weights to stay unchanged", opt.CallMe())
+		}
+	}
+}
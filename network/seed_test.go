@@ -0,0 +1,88 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/harungurubudi/rolade/activation"
+	"github.com/harungurubudi/rolade/optimizer"
+)
+
+func trainSeeded(t *testing.T, seed int64) *Network {
+	t.Helper()
+	nt, err := NewNetwork(3, 2, &activation.Tanh{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+	if err := nt.AddLayer(5, &activation.Sigmoid{}, nil); err != nil {
+		t.Fatalf("AddLayer error: %v", err)
+	}
+
+	nt.SetProps(Props{
+		Optimizer: optimizer.NewAdam(),
+		MaxEpoch:  20,
+		BatchSize: 8,
+		Shuffle:   true,
+		Seed:      seed,
+	})
+
+	// Enough samples to be trained in several concurrent batches
+	var features, targets []Vector
+	for i := range 40 {
+		x := float64(i) / 40
+		features = append(features, Vector{x, math.Sin(x * 6), 1 - x})
+		targets = append(targets, Vector{x, 1 - x})
+	}
+	samples, err := NewSamples(features, targets)
+	if err != nil {
+		t.Fatalf("Failed to create samples: %v", err)
+	}
+
+	if err := nt.Train(samples); err != nil {
+		t.Fatalf("Training failed: %v", err)
+	}
+	return nt
+}
+
+func TestSeedGivesIdenticalWeights(t *testing.T) {
+	a := trainSeeded(t, 42)
+	b := trainSeeded(t, 42)
+	for i := range a.synaptics {
+		if !reflect.DeepEqual(a.synaptics[i].weight, b.synaptics[i].weight) {
+			t.Errorf("Layer %d: Expected identical weights for the same seed", i)
+		}
+	}
+
+	c := trainSeeded(t, 7)
+	if reflect.DeepEqual(a.synaptics[0].weight, c.synaptics[0].weight) {
+		t.Errorf("Expected different weights for a different seed")
+	}
+}
+
+func TestSeedKeepsWeightsOfInterruptedTraining(t *testing.T) {
+	nt, err := NewNetwork(2, 1, &activation.Sigmoid{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nt.SetProps(Props{
+		Loss:      &cancellingLoss{cancel: cancel, after: 1},
+		BatchSize: 1,
+		Workers:   1,
+	})
+	if err := nt.TrainContext(ctx, getContextTestSamples()); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	// One update was applied although no epoch was counted
+	trained := cloneWeight(nt.synaptics[0].weight)
+	nt.SetProps(Props{Seed: 5})
+	if !reflect.DeepEqual(trained, nt.synaptics[0].weight) {
+		t.Errorf("Expected trained weights to be kept when seeding")
+	}
+}
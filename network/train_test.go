@@ -1,6 +1,7 @@
 package network_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/harungurubudi/rolade/activation"
@@ -31,13 +32,13 @@ func TestNetworkTrainXOR(t *testing.T) {
 	inputSize := 2
 	outputSize := 1
 
-	net, err := network.NewNetwork(inputSize, outputSize, &activation.ReLU{})
+	net, err := network.NewNetwork(inputSize, outputSize, &activation.ReLU{}, nil)
 	if err != nil {
 		t.Fatalf("AddLayer error: %v", err)
 	}
 
 	// Simple architecture: 1 hidden layer with 4 neurons
-	err = net.AddLayer(4, &activation.Sigmoid{})
+	err = net.AddLayer(4, &activation.Sigmoid{}, nil)
 	if err != nil {
 		t.Fatalf("AddLayer error: %v", err)
 	}
@@ -48,6 +49,7 @@ func TestNetworkTrainXOR(t *testing.T) {
 		MaxEpoch:  10000,
 		ErrLimit:  0.001,
 		Patience:  2000,
+		Seed:      1,
 	})
 
 	features, targets := generateXORData()
@@ -84,3 +86,128 @@ func TestNetworkTrainXOR(t *testing.T) {
 		t.Errorf("Expected accuracy >= 0.75, got %.2f", accuracy)
 	}
 }
+
+// generateClassData returns three well separated classes with one-hot targets.
+func generateClassData() (features []network.Vector, targets []network.Vector) {
+	features = []network.Vector{
+		{0.9, 0.1}, {0.8, 0.2}, {1, 0},
+		{0.1, 0.9}, {0.2, 0.8}, {0, 1},
+		{0.1, 0.1}, {0.2, 0.1}, {0, 0},
+	}
+	targets = []network.Vector{
+		{1, 0, 0}, {1, 0, 0}, {1, 0, 0},
+		{0, 1, 0}, {0, 1, 0}, {0, 1, 0},
+		{0, 0, 1}, {0, 0, 1}, {0, 0, 1},
+	}
+	return
+}
+
+func TestNetworkTrainSoftmax(t *testing.T) {
+	// Arrange
+	net, err := network.NewNetwork(2, 3, &activation.Tanh{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	err = net.AddLayer(8, &activation.Softmax{}, nil)
+	if err != nil {
+		t.Fatalf("AddLayer error: %v", err)
+	}
+
+	net.SetProps(network.Props{
+		Loss:      &loss.CategoricalCrossEntropy{},
+		Optimizer: optimizer.NewSGDWithLearningRate(0.5),
+		MaxEpoch:  2000,
+		ErrLimit:  0.01,
+		Patience:  500,
+	})
+
+	features, targets := generateClassData()
+	samples, err := network.NewSamples(features, targets)
+	if err != nil {
+		t.Fatalf("Failed to create samples: %v", err)
+	}
+
+	// Act
+	err = net.Train(samples)
+	if err != nil {
+		t.Fatalf("Training failed: %v", err)
+	}
+
+	// Assert: outputs are probabilities and the most probable class is right
+	correct := 0
+	for i, feature := range features {
+		output, conclusion, err := net.Test(feature)
+		if err != nil {
+			t.Errorf("Error during testing sample %d: %v", i, err)
+		}
+
+		var sum float64
+		for _, p := range output {
+			sum += p
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Errorf("Expected probabilities to sum to 1, got %f", sum)
+		}
+
+		match := true
+		for j := range conclusion {
+			if conclusion[j] != int(targets[i][j]) {
+				match = false
+			}
+		}
+		if match {
+			correct++
+		}
+	}
+
+	accuracy := float64(correct) / float64(len(features))
+	t.Logf("Softmax test accuracy: %.2f", accuracy)
+
+	if accuracy < 0.8 {
+		t.Errorf("Expected accuracy >= 0.8, got %.2f", accuracy)
+	}
+}
+
+func TestNetworkTrainRegression(t *testing.T) {
+	// y = 3x - 5 has targets well outside the range of squashing activations
+	net, err := network.NewNetwork(1, 1, &activation.Identity{}, nil)
+	if err != nil {
+		t.Fatalf("NewNetwork error: %v", err)
+	}
+
+	net.SetProps(network.Props{
+		Loss:       &loss.MSE{},
+		Optimizer:  optimizer.NewSGDWithLearningRate(0.1),
+		MaxEpoch:   2000,
+		ErrLimit:   1e-8,
+		Regression: true,
+	})
+
+	var features, targets []network.Vector
+	for _, x := range []float64{-2, -1, 0, 1, 2} {
+		features = append(features, network.Vector{x})
+		targets = append(targets, network.Vector{3*x - 5})
+	}
+	samples, err := network.NewSamples(features, targets)
+	if err != nil {
+		t.Fatalf("Failed to create samples: %v", err)
+	}
+
+	if err := net.Train(samples); err != nil {
+		t.Fatalf("Training failed: %v", err)
+	}
+
+	output, conclusion, err := net.Test(network.Vector{4})
+	if err != nil {
+		t.Fatalf("Test failed: %v", err)
+	}
+	if conclusion != nil {
+		t.Errorf("Expected no conclusion for regression, got %v", conclusion)
+	}
+
+	expected := float64(7)
+	if math.Abs(output[0]-expected) > 0.01 {
+		t.Errorf("Expected %f, get %f", expected, output[0])
+	}
+}
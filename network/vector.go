@@ -1,16 +1,47 @@
 package network
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrSamplesLengthMismatch = errors.New("features and targets must have the same length")
+	ErrFeatureSizeMismatch   = errors.New("feature size doesn't match the network's input size")
+	ErrTargetSizeMismatch    = errors.New("target size doesn't match the network's output size")
+	ErrClassWeightsMismatch  = errors.New("class weights must have one weight per target")
+	ErrNegativeWeight        = errors.New("sample weight must not be negative")
 )
 
+// SampleError reports a problem with a single sample. Index is the position of the sample in
+// the dataset passed to Train. Use errors.Is on it to check for the underlying error, e.g.
+// ErrFeatureSizeMismatch.
+type SampleError struct {
+	Index int
+	Err   error
+}
+
+func (e *SampleError) Error() string {
+	return fmt.Sprintf("got an error while train with data %d: %v", e.Index, e.Err)
+}
+
+func (e *SampleError) Unwrap() error {
+	return e.Err
+}
+
 type Vector []float64
 
+// Sample is a single training example.
+//
+// Fields:
+//   - Weight: Optionally scales the sample's contribution to the gradient and to the reported
+//     loss, e.g. to balance rare samples. Zero means unset and counts as 1, so samples that
+//     shouldn't count at all have to be left out of the dataset. Negative weights are rejected
+//     by Train with ErrNegativeWeight.
 type Sample struct {
 	Feature Vector
 	Target  Vector
+	Weight  float64
 }
 
 type Samples []Sample
@@ -30,6 +61,14 @@ func NewSamples(features []Vector, targets []Vector) (result Samples, err error)
 	return result, err
 }
 
+// lossWeight returns the weight of the sample, treating an unset weight as 1.
+func (s Sample) lossWeight() float64 {
+	if s.Weight == 0 {
+		return 1
+	}
+	return s.Weight
+}
+
 // Len returns the number of samples in the dataset.
 //
 // This is commonly used to determine batch sizes or iterate over the dataset.
@@ -37,13 +76,15 @@ func (l Samples) Len() int {
 	return len(l)
 }
 
+// Split divides the dataset into consecutive batches of batchSize samples. The last batch
+// holds the remaining samples and may be smaller.
 func (l Samples) Split(batchSize int) (batches []Samples) {
 	if batchSize <= 0 {
 		return nil
 	}
 
 	for i := 0; i < l.Len(); i += batchSize {
-		end := i + batchSize
+		end := min(i+batchSize, l.Len())
 		batches = append(batches, l[i:end])
 	}
 	return batches
@@ -0,0 +1,26 @@
+package network
+
+import (
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	var samples Samples
+	for i := range 7 {
+		samples = append(samples, Sample{Feature: Vector{float64(i)}, Target: Vector{0}})
+	}
+
+	batches := samples.Split(3)
+	expected := []int{3, 3, 1}
+	if len(batches) != len(expected) {
+		t.Fatalf("Expected %d batches, got %d", len(expected), len(batches))
+	}
+	for i, batch := range batches {
+		if batch.Len() != expected[i] {
+			t.Errorf("Batch %d: Expected %d samples, got %d", i, expected[i], batch.Len())
+		}
+	}
+	if batches[2][0].Feature[0] != 6 {
+		t.Errorf("Expected the last batch to hold the last sample, got %v", batches[2][0].Feature)
+	}
+}
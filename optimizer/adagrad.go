@@ -0,0 +1,67 @@
+package optimizer
+
+import (
+	"math"
+
+	"github.com/harungurubudi/rolade/model"
+)
+
+// Adagrad (Adaptive Gradient) scales the learning rate of every parameter
+// by the inverse square root of the sum of all its past squared gradients.
+// Parameters that receive large or frequent gradients get smaller steps,
+// which works well for sparse features, while the effective learning rate
+// keeps shrinking over the course of training.
+//
+// Defaults are only applied by NewAdagrad and Generate, so a field set to zero, e.g. Alpha
+// scheduled down to zero, stays zero.
+//
+// Fields:
+//   - Alpha: Learning rate, controls the step size in each iteration.
+//   - Epsilon: Small constant that prevents division by zero.
+//   - Sum: Accumulated squared gradients of each weight and bias.
+type Adagrad struct {
+	Alpha   float64
+	Epsilon float64
+	Sum     Slots
+}
+
+func NewAdagrad() *Adagrad {
+	o := &Adagrad{}
+	o.initialize()
+	return o
+}
+
+func NewAdagradWithLearningRate(alpha float64) *Adagrad {
+	adagrad := NewAdagrad()
+	adagrad.Alpha = alpha
+	return adagrad
+}
+
+func (o *Adagrad) Step(grads []model.Weight) []model.Weight {
+	return step(grads, func(grad float64, state []*float64) float64 {
+		sum := state[0]
+		*sum += grad * grad
+		return -o.Alpha * grad / (math.Sqrt(*sum) + o.Epsilon)
+	}, &o.Sum)
+}
+
+func (o *Adagrad) initialize() {
+	if o.Alpha == 0 {
+		o.Alpha = float64(0.01)
+	}
+	if o.Epsilon == 0 {
+		o.Epsilon = float64(1e-10)
+	}
+}
+
+func (o *Adagrad) LearningRate() float64 {
+	return o.Alpha
+}
+
+func (o *Adagrad) SetLearningRate(alpha float64) {
+	o.Alpha = alpha
+}
+
+func (o *Adagrad) CallMe() string {
+	return "adagrad"
+}
@@ -0,0 +1,33 @@
+package optimizer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStepAdagrad(t *testing.T) {
+	adagrad := NewAdagradWithLearningRate(0.1)
+
+	// First update: sum = g², so the step is -alpha * sign(g), up to epsilon
+	got := adagrad.Step(getSingleGradient(0.5, -2))
+	for _, c := range []struct{ expected, got float64 }{
+		{-0.1, got[0].Weight[0][0]},
+		{0.1, got[0].Bias[0]},
+	} {
+		if math.Abs(c.got-c.expected) > 1e-9 {
+			t.Errorf("Expected %f, get %f", c.expected, c.got)
+		}
+	}
+
+	// Second update: sum = 0.25 + 0.0625 for the weight and 4 + 1 for the bias, which keeps
+	// its own sum
+	got = adagrad.Step(getSingleGradient(0.25, -1))
+	for _, c := range []struct{ expected, got float64 }{
+		{-0.1 * 0.25 / (math.Sqrt(0.3125) + 1e-10), got[0].Weight[0][0]},
+		{0.1 * 1 / (math.Sqrt(5) + 1e-10), got[0].Bias[0]},
+	} {
+		if math.Abs(c.got-c.expected) > 1e-9 {
+			t.Errorf("Expected %f, get %f", c.expected, c.got)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package optimizer
+
+import (
+	"math"
+
+	"github.com/harungurubudi/rolade/model"
+)
+
+// Adam (Adaptive Moment Estimation) is an optimization algorithm that keeps
+// exponentially decaying averages of past gradients (first moment) and past
+// squared gradients (second moment) for every parameter, and scales each
+// update by their bias-corrected ratio. It adapts the step size per
+// parameter and usually converges much faster than plain SGD on deep networks.
+//
+// Defaults are only applied by NewAdam and Generate, so a field set to zero, e.g. Beta1 for
+// Adam without momentum or Alpha scheduled down to zero, stays zero.
+//
+// Fields:
+//   - Alpha: Learning rate, controls the step size in each iteration.
+//   - Beta1: Decay rate of the first moment estimate.
+//   - Beta2: Decay rate of the second moment estimate.
+//   - Epsilon: Small constant that prevents division by zero.
+//   - M: First moment estimate of each weight and bias.
+//   - V: Second moment estimate of each weight and bias.
+//   - T: Number of updates applied so far, used for bias correction.
+type Adam struct {
+	Alpha   float64
+	Beta1   float64
+	Beta2   float64
+	Epsilon float64
+	M       Slots
+	V       Slots
+	T       int
+}
+
+func NewAdam() *Adam {
+	o := &Adam{}
+	o.initialize()
+	return o
+}
+
+func NewAdamWithLearningRate(alpha float64) *Adam {
+	adam := NewAdam()
+	adam.Alpha = alpha
+	return adam
+}
+
+func (o *Adam) Step(grads []model.Weight) []model.Weight {
+	o.T++
+
+	correction1 := 1 - math.Pow(o.Beta1, float64(o.T))
+	correction2 := 1 - math.Pow(o.Beta2, float64(o.T))
+
+	return step(grads, func(grad float64, state []*float64) float64 {
+		m, v := state[0], state[1]
+		*m = o.Beta1*(*m) + (1-o.Beta1)*grad
+		*v = o.Beta2*(*v) + (1-o.Beta2)*grad*grad
+
+		mHat := *m / correction1
+		vHat := *v / correction2
+
+		return -o.Alpha * mHat / (math.Sqrt(vHat) + o.Epsilon)
+	}, &o.M, &o.V)
+}
+
+func (o *Adam) initialize() {
+	if o.Alpha == 0 {
+		o.Alpha = float64(0.001)
+	}
+	if o.Beta1 == 0 {
+		o.Beta1 = float64(0.9)
+	}
+	if o.Beta2 == 0 {
+		o.Beta2 = float64(0.999)
+	}
+	if o.Epsilon == 0 {
+		o.Epsilon = float64(1e-8)
+	}
+}
+
+func (o *Adam) LearningRate() float64 {
+	return o.Alpha
+}
+
+func (o *Adam) SetLearningRate(alpha float64) {
+	o.Alpha = alpha
+}
+
+func (o *Adam) CallMe() string {
+	return "adam"
+}
@@ -0,0 +1,64 @@
+package optimizer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/harungurubudi/rolade/model"
+)
+
+// getSingleGradient returns one layer with a single weight and a single bias gradient.
+func getSingleGradient(w float64, b float64) []model.Weight {
+	return []model.Weight{
+		{
+			Weight: [][]float64{{w}},
+			Bias:   []float64{b},
+		},
+	}
+}
+
+func TestStepAdam(t *testing.T) {
+	adam := NewAdamWithLearningRate(0.1)
+
+	// First update: m = 0.1g, v = 0.001g², bias corrected to g and g², so the step is
+	// -alpha * sign(g), up to epsilon.
+	got := adam.Step(getSingleGradient(0.5, -2))
+	for _, c := range []struct{ expected, got float64 }{
+		{-0.1, got[0].Weight[0][0]},
+		{0.1, got[0].Bias[0]},
+	} {
+		if math.Abs(c.got-c.expected) > 1e-6 {
+			t.Errorf("Expected %f, get %f", c.expected, c.got)
+		}
+	}
+
+	// Second update of the weight with g = 0.25:
+	// m = 0.9*0.05 + 0.1*0.25 = 0.07, mHat = 0.07/0.19
+	// v = 0.999*0.00025 + 0.001*0.0625 = 0.00031225, vHat = 0.00031225/0.001999
+	got = adam.Step(getSingleGradient(0.25, -2))
+	expected := -0.1 * (0.07 / 0.19) / (math.Sqrt(0.00031225/0.001999) + 1e-8)
+	if math.Abs(got[0].Weight[0][0]-expected) > 1e-9 {
+		t.Errorf("Expected %f, get %f", expected, got[0].Weight[0][0])
+	}
+	// The bias keeps its own moments, so a constant gradient keeps a step of -alpha * sign(g)
+	if math.Abs(got[0].Bias[0]-0.1) > 1e-6 {
+		t.Errorf("Expected %f, get %f", 0.1, got[0].Bias[0])
+	}
+}
+
+func TestStepAdamKeepsZeroBeta1(t *testing.T) {
+	adam := NewAdamWithLearningRate(0.1)
+	adam.Beta1 = 0
+
+	adam.Step(getSingleGradient(0.5, 0))
+	got := adam.Step(getSingleGradient(0.25, 0))
+
+	// Without momentum m is the latest gradient and needs no bias correction
+	expected := -0.1 * 0.25 / (math.Sqrt((0.999*0.00025+0.001*0.0625)/0.001999) + 1e-8)
+	if math.Abs(got[0].Weight[0][0]-expected) > 1e-9 {
+		t.Errorf("Expected %f, get %f", expected, got[0].Weight[0][0])
+	}
+	if adam.Beta1 != 0 {
+		t.Errorf("Expected Beta1 to stay 0, get %f", adam.Beta1)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/harungurubudi/rolade/model"
 )
@@ -20,6 +21,31 @@ var registry = map[string]func(string) (IOptimizer, error){
 		}
 		return &o, nil
 	},
+	"adam": func(props string) (IOptimizer, error) {
+		// Defaults only fill the fields missing from props, so stored zeros are kept
+		o := NewAdam()
+		err := json.Unmarshal([]byte(props), o)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating optimizer function: %v", err)
+		}
+		return o, nil
+	},
+	"rmsprop": func(props string) (IOptimizer, error) {
+		o := NewRMSProp()
+		err := json.Unmarshal([]byte(props), o)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating optimizer function: %v", err)
+		}
+		return o, nil
+	},
+	"adagrad": func(props string) (IOptimizer, error) {
+		o := NewAdagrad()
+		err := json.Unmarshal([]byte(props), o)
+		if err != nil {
+			return nil, fmt.Errorf("got error while generating optimizer function: %v", err)
+		}
+		return o, nil
+	},
 }
 
 // Generate creates an IOptimizer instance based on the given model.Attr,
@@ -32,8 +58,36 @@ var registry = map[string]func(string) (IOptimizer, error){
 //	attr := &model.Attr{Name: "sgd", Props: "{\"LearningRate\":0.01}"}
 //	optimizer, err := Generate(attr)
 func Generate(attr *model.Attr) (IOptimizer, error) {
-	if gen, ok := registry[strings.ToLower(attr.Name)]; ok {
+	registryMu.RLock()
+	gen, ok := registry[strings.ToLower(attr.Name)]
+	registryMu.RUnlock()
+	if ok {
 		return gen(attr.Props)
 	}
 	return nil, fmt.Errorf("unsupported optimizer: %s", attr.Name)
 }
+
+// registryMu guards registry, which Register may change while profiles are loaded.
+var registryMu sync.RWMutex
+
+// Register adds an optimizer to the registry under the given name, so profiles
+// referring to it can be restored with Generate. The name must match what the optimizer's
+// CallMe returns, and is case-insensitive. gen receives the JSON-encoded properties
+// stored in the profile.
+//
+// It is safe for concurrent use. Registering a name that is already taken, including
+// the name of a built-in optimizer, returns an error.
+func Register(name string, gen func(props string) (IOptimizer, error)) error {
+	if name == "" || gen == nil {
+		return fmt.Errorf("got error while registering optimizer: name and constructor are required")
+	}
+
+	key := strings.ToLower(name)
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[key]; ok {
+		return fmt.Errorf("got error while registering optimizer: %s is already registered", name)
+	}
+	registry[key] = gen
+	return nil
+}
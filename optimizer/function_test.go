@@ -1,6 +1,8 @@
 package optimizer
 
 import (
+	"encoding/json"
+	"math"
 	"reflect"
 	"testing"
 
@@ -24,3 +26,135 @@ func TestGenerateSGD(t *testing.T) {
 		t.Errorf("Error test optimizer generator : Expected %s, got %s", expectedType, resultType)
 	}
 }
+
+func TestGenerateAdam(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "adam",
+		Props: `{"Alpha": 0.001, "Beta1": 0.9, "Beta2": 0.999, "Epsilon": 1e-8}`,
+	}
+
+	optimizer, err := Generate(attr)
+	if err != nil {
+		t.Errorf("Error test optimizer generator : %v", err)
+	}
+
+	expectedType := "Adam"
+	resultType := reflect.TypeOf(optimizer).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test optimizer generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestGenerateRMSProp(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "rmsprop",
+		Props: `{"Alpha": 0.001, "Decay": 0.9, "Epsilon": 1e-8, "Centered": true}`,
+	}
+
+	optimizer, err := Generate(attr)
+	if err != nil {
+		t.Errorf("Error test optimizer generator : %v", err)
+	}
+
+	expectedType := "RMSProp"
+	resultType := reflect.TypeOf(optimizer).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test optimizer generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestGenerateAdagrad(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "adagrad",
+		Props: `{"Alpha": 0.01, "Epsilon": 1e-10}`,
+	}
+
+	optimizer, err := Generate(attr)
+	if err != nil {
+		t.Errorf("Error test optimizer generator : %v", err)
+	}
+
+	expectedType := "Adagrad"
+	resultType := reflect.TypeOf(optimizer).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test optimizer generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func getGradients() []model.Weight {
+	return []model.Weight{
+		{
+			Weight: [][]float64{{0.5, -0.1}, {0.02, 0.3}},
+			Bias:   []float64{-0.25, 0.1},
+		},
+	}
+}
+
+func TestGenerateAdamRestoresMoments(t *testing.T) {
+	adam := NewAdam()
+	adam.Step(getGradients())
+
+	props, err := json.Marshal(adam)
+	if err != nil {
+		t.Fatalf("Error marshalling optimizer : %v", err)
+	}
+
+	restored, err := Generate(&model.Attr{Name: adam.CallMe(), Props: string(props)})
+	if err != nil {
+		t.Fatalf("Error test optimizer generator : %v", err)
+	}
+
+	expected := adam.Step(getGradients())
+	got := restored.Step(getGradients())
+	for i := range expected {
+		for j := range expected[i].Weight {
+			for k := range expected[i].Weight[j] {
+				if math.Abs(got[i].Weight[j][k]-expected[i].Weight[j][k]) > 1e-12 {
+					t.Errorf("Expected %f, get %f", expected[i].Weight[j][k], got[i].Weight[j][k])
+				}
+			}
+		}
+		for j := range expected[i].Bias {
+			if math.Abs(got[i].Bias[j]-expected[i].Bias[j]) > 1e-12 {
+				t.Errorf("Expected %f, get %f", expected[i].Bias[j], got[i].Bias[j])
+			}
+		}
+	}
+}
+
+type customOptimizer struct {
+	SGD
+}
+
+func (o *customOptimizer) CallMe() string {
+	return "custom_optimizer"
+}
+
+func TestRegister(t *testing.T) {
+	gen := func(props string) (IOptimizer, error) {
+		var o customOptimizer
+		if err := json.Unmarshal([]byte(props), &o); err != nil {
+			return nil, err
+		}
+		return &o, nil
+	}
+	if err := Register("custom_optimizer", gen); err != nil {
+		t.Fatalf("Error test optimizer register : %v", err)
+	}
+	t.Cleanup(func() { delete(registry, "custom_optimizer") })
+
+	o, err := Generate(&model.Attr{Name: "custom_optimizer", Props: `{"Alpha":0.3}`})
+	if err != nil {
+		t.Fatalf("Error test optimizer generator : %v", err)
+	}
+	if got := o.LearningRate(); got != 0.3 {
+		t.Errorf("Expected %f, get %f", 0.3, got)
+	}
+
+	if err := Register("custom_optimizer", gen); err == nil {
+		t.Errorf("Expected error registering a duplicate name")
+	}
+	if err := Register("adam", gen); err == nil {
+		t.Errorf("Expected error registering a built-in name")
+	}
+}
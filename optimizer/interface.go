@@ -1,6 +1,21 @@
 package optimizer
 
+import "github.com/harungurubudi/rolade/model"
+
+// IOptimizer turns gradients into parameter updates.
+//
+// Step receives the gradient of the loss with respect to every weight and
+// bias of the network, one model.Weight per layer, and returns the deltas to
+// be added to those parameters in the same layout. It is called exactly once
+// per update, so optimizers may keep their state per parameter slot.
+//
+// LearningRate reports the step size currently used by the optimizer. It is
+// used to scale updates that happen outside the optimizer, such as
+// decoupled weight decay. SetLearningRate replaces it, which lets a
+// learning-rate scheduler drive the optimizer between epochs.
 type IOptimizer interface {
-	CalculateDelta(grad float64) (float64)
+	Step(grads []model.Weight) (deltas []model.Weight)
+	LearningRate() float64
+	SetLearningRate(alpha float64)
 	CallMe() string
-}
\ No newline at end of file
+}
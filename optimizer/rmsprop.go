@@ -0,0 +1,86 @@
+package optimizer
+
+import (
+	"math"
+
+	"github.com/harungurubudi/rolade/model"
+)
+
+// RMSProp (Root Mean Square Propagation) divides the learning rate of every
+// parameter by a running average of the magnitudes of its recent gradients.
+// The centered variant additionally tracks the running average of the
+// gradients themselves and normalizes by the estimated variance instead,
+// which is often more stable at the cost of some extra state.
+//
+// Defaults are only applied by NewRMSProp and Generate, so a field set to zero, e.g. Alpha
+// scheduled down to zero, stays zero.
+//
+// Fields:
+//   - Alpha: Learning rate, controls the step size in each iteration.
+//   - Decay: Decay rate of the running averages.
+//   - Epsilon: Small constant that prevents division by zero.
+//   - Centered: If true, normalizes by the variance instead of the second moment.
+//   - S: Running average of the squared gradient of each weight and bias.
+//   - G: Running average of the gradient of each weight and bias, only used when Centered.
+type RMSProp struct {
+	Alpha    float64
+	Decay    float64
+	Epsilon  float64
+	Centered bool
+	S        Slots
+	G        Slots
+}
+
+func NewRMSProp() *RMSProp {
+	o := &RMSProp{}
+	o.initialize()
+	return o
+}
+
+func NewRMSPropWithLearningRate(alpha float64) *RMSProp {
+	rmsprop := NewRMSProp()
+	rmsprop.Alpha = alpha
+	return rmsprop
+}
+
+func (o *RMSProp) Step(grads []model.Weight) []model.Weight {
+	if !o.Centered {
+		return step(grads, func(grad float64, state []*float64) float64 {
+			s := state[0]
+			*s = o.Decay*(*s) + (1-o.Decay)*grad*grad
+			return -o.Alpha * grad / (math.Sqrt(*s) + o.Epsilon)
+		}, &o.S)
+	}
+
+	return step(grads, func(grad float64, state []*float64) float64 {
+		s, g := state[0], state[1]
+		*s = o.Decay*(*s) + (1-o.Decay)*grad*grad
+		*g = o.Decay*(*g) + (1-o.Decay)*grad
+		variance := math.Max(*s-(*g)*(*g), 0)
+		return -o.Alpha * grad / (math.Sqrt(variance) + o.Epsilon)
+	}, &o.S, &o.G)
+}
+
+func (o *RMSProp) initialize() {
+	if o.Alpha == 0 {
+		o.Alpha = float64(0.001)
+	}
+	if o.Decay == 0 {
+		o.Decay = float64(0.9)
+	}
+	if o.Epsilon == 0 {
+		o.Epsilon = float64(1e-8)
+	}
+}
+
+func (o *RMSProp) LearningRate() float64 {
+	return o.Alpha
+}
+
+func (o *RMSProp) SetLearningRate(alpha float64) {
+	o.Alpha = alpha
+}
+
+func (o *RMSProp) CallMe() string {
+	return "rmsprop"
+}
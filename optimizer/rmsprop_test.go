@@ -0,0 +1,47 @@
+package optimizer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStepRMSProp(t *testing.T) {
+	rmsprop := NewRMSPropWithLearningRate(0.1)
+
+	// First update: s = 0.1g², so the step is -alpha * g / sqrt(0.1g²)
+	got := rmsprop.Step(getSingleGradient(0.5, -2))
+	for _, c := range []struct{ expected, got float64 }{
+		{-0.1 * 0.5 / (math.Sqrt(0.025) + 1e-8), got[0].Weight[0][0]},
+		{0.1 * 2 / (math.Sqrt(0.4) + 1e-8), got[0].Bias[0]},
+	} {
+		if math.Abs(c.got-c.expected) > 1e-9 {
+			t.Errorf("Expected %f, get %f", c.expected, c.got)
+		}
+	}
+
+	// Second update: s = 0.9*0.025 + 0.1*0.0625 = 0.02875 for the weight, while the bias
+	// keeps its own average: s = 0.9*0.4 + 0.1*4 = 0.76
+	got = rmsprop.Step(getSingleGradient(0.25, -2))
+	for _, c := range []struct{ expected, got float64 }{
+		{-0.1 * 0.25 / (math.Sqrt(0.02875) + 1e-8), got[0].Weight[0][0]},
+		{0.1 * 2 / (math.Sqrt(0.76) + 1e-8), got[0].Bias[0]},
+	} {
+		if math.Abs(c.got-c.expected) > 1e-9 {
+			t.Errorf("Expected %f, get %f", c.expected, c.got)
+		}
+	}
+}
+
+func TestStepRMSPropCentered(t *testing.T) {
+	rmsprop := NewRMSPropWithLearningRate(0.1)
+	rmsprop.Centered = true
+
+	rmsprop.Step(getSingleGradient(0.5, 0))
+	got := rmsprop.Step(getSingleGradient(0.25, 0))
+
+	// s = 0.02875, g = 0.9*0.05 + 0.1*0.25 = 0.07, variance = s - g²
+	expected := -0.1 * 0.25 / (math.Sqrt(0.02875-0.07*0.07) + 1e-8)
+	if math.Abs(got[0].Weight[0][0]-expected) > 1e-9 {
+		t.Errorf("Expected %f, get %f", expected, got[0].Weight[0][0])
+	}
+}
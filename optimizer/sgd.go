@@ -1,19 +1,21 @@
 package optimizer
 
+import "github.com/harungurubudi/rolade/model"
+
 // SGD (Stochastic Gradient Descent) is a basic optimization algorithm
 // that updates model parameters by moving in the direction of the negative gradient
 // of the loss function. It supports momentum and optional Nesterov acceleration.
 //
 // Fields:
 //   - Alpha: Learning rate, controls the step size in each iteration.
-//   - M: Momentum factor, helps accelerate gradients in the right direction.
+//   - Momentum: Momentum factor, helps accelerate gradients in the right direction.
 //   - IsNesterov: If true, applies Nesterov accelerated gradient.
-//   - V: Internal velocity term used for momentum calculation.
+//   - Velocities: Internal velocity of each weight and bias used for momentum calculation.
 type SGD struct {
 	Alpha      float64
 	Momentum   float64
 	IsNesterov bool
-	Velocity   float64
+	Velocities Slots
 }
 
 func NewSGD() *SGD {
@@ -28,20 +30,21 @@ func NewSGDWithLearningRate(alpha float64) *SGD {
 	return sgd
 }
 
-func (o *SGD) CalculateDelta(grad float64) float64 {
-	var delta float64
+func (o *SGD) Step(grads []model.Weight) []model.Weight {
 	if o.Momentum == 0 {
-		delta = o.Alpha * grad
-	} else {
-		o.Velocity = o.Momentum*o.Velocity + o.Alpha*grad
-		if o.IsNesterov {
-			delta = o.Velocity
-		} else {
-			delta = o.Momentum*o.Velocity + o.Alpha*grad
-		}
+		return step(grads, func(grad float64, _ []*float64) float64 {
+			return -o.Alpha * grad
+		})
 	}
 
-	return delta
+	return step(grads, func(grad float64, state []*float64) float64 {
+		velocity := state[0]
+		*velocity = o.Momentum*(*velocity) - o.Alpha*grad
+		if o.IsNesterov {
+			return o.Momentum*(*velocity) - o.Alpha*grad
+		}
+		return *velocity
+	}, &o.Velocities)
 }
 
 func (o *SGD) initialize() {
@@ -50,6 +53,14 @@ func (o *SGD) initialize() {
 	}
 }
 
+func (o *SGD) LearningRate() float64 {
+	return o.Alpha
+}
+
+func (o *SGD) SetLearningRate(alpha float64) {
+	o.Alpha = alpha
+}
+
 func (o *SGD) CallMe() string {
 	return "sgd"
 }
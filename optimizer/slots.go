@@ -0,0 +1,80 @@
+package optimizer
+
+import "github.com/harungurubudi/rolade/model"
+
+// Slots holds one value per trainable parameter, laid out like the layers of
+// the network. Optimizers use it to keep per-parameter state such as moment
+// estimates. It grows on demand, so the optimizer doesn't need to know the
+// network shape up front, and it serializes together with the optimizer.
+type Slots []model.Weight
+
+// fit makes sure the slots have at least the shape of grads, allocating
+// zeroed values for any layer, row or column that is missing.
+func (s *Slots) fit(grads []model.Weight) {
+	for len(*s) < len(grads) {
+		*s = append(*s, model.Weight{})
+	}
+
+	for i, layer := range grads {
+		slot := &(*s)[i]
+		for len(slot.Weight) < len(layer.Weight) {
+			slot.Weight = append(slot.Weight, nil)
+		}
+		for j, row := range layer.Weight {
+			slot.Weight[j] = grow(slot.Weight[j], len(row))
+		}
+		slot.Bias = grow(slot.Bias, len(layer.Bias))
+		slot.Params = grow(slot.Params, len(layer.Params))
+	}
+}
+
+func grow(vals []float64, size int) []float64 {
+	if len(vals) < size {
+		vals = append(vals, make([]float64, size-len(vals))...)
+	}
+	return vals
+}
+
+// step builds the deltas for grads by calling fn once per parameter. fn gets
+// the parameter's gradient and a pointer to its value in each of the given
+// slots, in the same order, and returns the delta for that parameter.
+func step(grads []model.Weight, fn func(grad float64, state []*float64) float64, slots ...*Slots) []model.Weight {
+	for _, s := range slots {
+		s.fit(grads)
+	}
+
+	state := make([]*float64, len(slots))
+	deltas := make([]model.Weight, len(grads))
+	for i, layer := range grads {
+		deltas[i].Weight = make([][]float64, len(layer.Weight))
+		for j, row := range layer.Weight {
+			deltas[i].Weight[j] = make([]float64, len(row))
+			for k, grad := range row {
+				for n, s := range slots {
+					state[n] = &(*s)[i].Weight[j][k]
+				}
+				deltas[i].Weight[j][k] = fn(grad, state)
+			}
+		}
+
+		deltas[i].Bias = make([]float64, len(layer.Bias))
+		for j, grad := range layer.Bias {
+			for n, s := range slots {
+				state[n] = &(*s)[i].Bias[j]
+			}
+			deltas[i].Bias[j] = fn(grad, state)
+		}
+
+		if len(layer.Params) > 0 {
+			deltas[i].Params = make([]float64, len(layer.Params))
+			for j, grad := range layer.Params {
+				for n, s := range slots {
+					state[n] = &(*s)[i].Params[j]
+				}
+				deltas[i].Params[j] = fn(grad, state)
+			}
+		}
+	}
+
+	return deltas
+}
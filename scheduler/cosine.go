@@ -0,0 +1,55 @@
+package scheduler
+
+import "math"
+
+// CosineAnnealing follows half a cosine wave from the base learning rate down
+// to MinRate over Period epochs, then restarts from the base rate (SGDR,
+// stochastic gradient descent with warm restarts). Every restart the period
+// is multiplied by Mult, so later cycles can anneal more slowly.
+//
+// Fields:
+//   - Period: Length in epochs of the first cycle.
+//   - Mult: Factor by which the period grows after every restart.
+//   - MinRate: Lowest learning rate reached at the end of each cycle.
+type CosineAnnealing struct {
+	Period  int
+	Mult    int
+	MinRate float64
+}
+
+func NewCosineAnnealing(period int, mult int, minRate float64) *CosineAnnealing {
+	s := &CosineAnnealing{
+		Period:  period,
+		Mult:    mult,
+		MinRate: minRate,
+	}
+	s.initialize()
+	return s
+}
+
+func (s *CosineAnnealing) Rate(epoch int, base float64, _ []float64) float64 {
+	s.initialize()
+
+	// Find the position of epoch inside its restart cycle
+	current, period := epoch, s.Period
+	for current >= period {
+		current -= period
+		period *= s.Mult
+	}
+
+	progress := float64(current) / float64(period)
+	return s.MinRate + (base-s.MinRate)*(1+math.Cos(math.Pi*progress))/2
+}
+
+func (s *CosineAnnealing) initialize() {
+	if s.Period <= 0 {
+		s.Period = 10
+	}
+	if s.Mult <= 0 {
+		s.Mult = 1
+	}
+}
+
+func (s *CosineAnnealing) CallMe() string {
+	return "cosine"
+}
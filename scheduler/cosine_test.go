@@ -0,0 +1,27 @@
+package scheduler
+
+import (
+	"math"
+	"testing"
+)
+
+func getCosineAnnealingExpectedValues() map[int]float64 {
+	return map[int]float64{
+		0:  0.1,
+		5:  0.05,
+		10: 0.1, // first restart, the next cycle lasts 20 epochs
+		20: 0.05,
+		30: 0.1, // second restart
+	}
+}
+
+func TestRateCosineAnnealing(t *testing.T) {
+	scheduler := NewCosineAnnealing(10, 2, 0)
+	vals := getCosineAnnealingExpectedValues()
+	for epoch, expected := range vals {
+		got := scheduler.Rate(epoch, 0.1, nil)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Epoch %d: expected %f, get %f", epoch, expected, got)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package scheduler
+
+import "math"
+
+// ExponentialDecay multiplies the learning rate by Gamma after every epoch,
+// so the rate at epoch n is base * Gamma^n.
+//
+// Fields:
+//   - Gamma: Multiplicative factor applied per epoch.
+type ExponentialDecay struct {
+	Gamma float64
+}
+
+func NewExponentialDecay(gamma float64) *ExponentialDecay {
+	s := &ExponentialDecay{
+		Gamma: gamma,
+	}
+	s.initialize()
+	return s
+}
+
+func (s *ExponentialDecay) Rate(epoch int, base float64, _ []float64) float64 {
+	s.initialize()
+	return base * math.Pow(s.Gamma, float64(epoch))
+}
+
+func (s *ExponentialDecay) initialize() {
+	if s.Gamma == 0 {
+		s.Gamma = float64(0.99)
+	}
+}
+
+func (s *ExponentialDecay) CallMe() string {
+	return "exponential"
+}
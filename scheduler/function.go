@@ -0,0 +1,97 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/harungurubudi/rolade/model"
+)
+
+// registry maps scheduler names to their corresponding constructor functions.
+// Each function takes a JSON-encoded string of scheduler properties, including
+// any internal state, and returns an IScheduler instance or an error.
+var registry = map[string]func(string) (IScheduler, error){
+	"step": func(props string) (IScheduler, error) {
+		var s StepDecay
+		if err := json.Unmarshal([]byte(props), &s); err != nil {
+			return nil, fmt.Errorf("got error while generating scheduler: %v", err)
+		}
+		s.initialize()
+		return &s, nil
+	},
+	"exponential": func(props string) (IScheduler, error) {
+		var s ExponentialDecay
+		if err := json.Unmarshal([]byte(props), &s); err != nil {
+			return nil, fmt.Errorf("got error while generating scheduler: %v", err)
+		}
+		s.initialize()
+		return &s, nil
+	},
+	"cosine": func(props string) (IScheduler, error) {
+		var s CosineAnnealing
+		if err := json.Unmarshal([]byte(props), &s); err != nil {
+			return nil, fmt.Errorf("got error while generating scheduler: %v", err)
+		}
+		s.initialize()
+		return &s, nil
+	},
+	"warmup": func(props string) (IScheduler, error) {
+		var s LinearWarmup
+		if err := json.Unmarshal([]byte(props), &s); err != nil {
+			return nil, fmt.Errorf("got error while generating scheduler: %v", err)
+		}
+		s.initialize()
+		return &s, nil
+	},
+	"plateau": func(props string) (IScheduler, error) {
+		var s ReduceOnPlateau
+		if err := json.Unmarshal([]byte(props), &s); err != nil {
+			return nil, fmt.Errorf("got error while generating scheduler: %v", err)
+		}
+		s.initialize()
+		return &s, nil
+	},
+}
+
+// Load returns an IScheduler implementation based on the given profile attribute.
+// It looks up the scheduler name in the registry and invokes its constructor.
+// If the scheduler is not supported, it returns an error.
+//
+// This is typically used when restoring a model from a saved profile, so a
+// resumed run continues the schedule where it stopped.
+func Load(attr *model.Attr) (IScheduler, error) {
+	registryMu.RLock()
+	gen, ok := registry[strings.ToLower(attr.Name)]
+	registryMu.RUnlock()
+	if ok {
+		return gen(attr.Props)
+	}
+	return nil, fmt.Errorf("unsupported scheduler: %s", attr.Name)
+}
+
+// registryMu guards registry, which Register may change while profiles are loaded.
+var registryMu sync.RWMutex
+
+// Register adds a scheduler to the registry under the given name, so profiles
+// referring to it can be restored with Load. The name must match what the scheduler's
+// CallMe returns, and is case-insensitive. gen receives the JSON-encoded properties
+// stored in the profile.
+//
+// It is safe for concurrent use. Registering a name that is already taken, including
+// the name of a built-in scheduler, returns an error.
+func Register(name string, gen func(props string) (IScheduler, error)) error {
+	if name == "" || gen == nil {
+		return fmt.Errorf("got error while registering scheduler: name and constructor are required")
+	}
+
+	key := strings.ToLower(name)
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[key]; ok {
+		return fmt.Errorf("got error while registering scheduler: %s is already registered", name)
+	}
+	registry[key] = gen
+	return nil
+}
@@ -0,0 +1,129 @@
+package scheduler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/harungurubudi/rolade/model"
+)
+
+func TestLoadStepDecay(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "step",
+		Props: `{"StepSize": 10, "Gamma": 0.5}`,
+	}
+
+	scheduler, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test scheduler generator : %v", err)
+	}
+
+	expectedType := "StepDecay"
+	resultType := reflect.TypeOf(scheduler).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test scheduler generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadExponentialDecay(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "exponential",
+		Props: `{"Gamma": 0.95}`,
+	}
+
+	scheduler, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test scheduler generator : %v", err)
+	}
+
+	expectedType := "ExponentialDecay"
+	resultType := reflect.TypeOf(scheduler).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test scheduler generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadCosineAnnealing(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "cosine",
+		Props: `{"Period": 10, "Mult": 2, "MinRate": 0.0001}`,
+	}
+
+	scheduler, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test scheduler generator : %v", err)
+	}
+
+	expectedType := "CosineAnnealing"
+	resultType := reflect.TypeOf(scheduler).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test scheduler generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadLinearWarmup(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "warmup",
+		Props: `{"Epochs": 5, "StartFactor": 0.1}`,
+	}
+
+	scheduler, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test scheduler generator : %v", err)
+	}
+
+	expectedType := "LinearWarmup"
+	resultType := reflect.TypeOf(scheduler).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test scheduler generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+func TestLoadReduceOnPlateau(t *testing.T) {
+	attr := &model.Attr{
+		Name:  "plateau",
+		Props: `{"Factor": 0.5, "Patience": 3, "Best": 0.2, "Wait": 1, "Scale": 0.25}`,
+	}
+
+	scheduler, err := Load(attr)
+	if err != nil {
+		t.Errorf("Error test scheduler generator : %v", err)
+	}
+
+	expectedType := "ReduceOnPlateau"
+	resultType := reflect.TypeOf(scheduler).Elem().Name()
+	if resultType != expectedType {
+		t.Errorf("Error test scheduler generator : Expected %s, got %s", expectedType, resultType)
+	}
+}
+
+type customScheduler struct {
+	StepDecay
+}
+
+func (s *customScheduler) CallMe() string {
+	return "custom_scheduler"
+}
+
+func TestRegister(t *testing.T) {
+	gen := func(_ string) (IScheduler, error) { return &customScheduler{}, nil }
+	if err := Register("custom_scheduler", gen); err != nil {
+		t.Fatalf("Error test scheduler register : %v", err)
+	}
+	t.Cleanup(func() { delete(registry, "custom_scheduler") })
+
+	s, err := Load(&model.Attr{Name: "custom_scheduler", Props: "{}"})
+	if err != nil {
+		t.Fatalf("Error test scheduler generator : %v", err)
+	}
+	if _, ok := s.(*customScheduler); !ok {
+		t.Errorf("Error test scheduler generator : Expected customScheduler, got %T", s)
+	}
+
+	if err := Register("custom_scheduler", gen); err == nil {
+		t.Errorf("Expected error registering a duplicate name")
+	}
+	if err := Register("cosine", gen); err == nil {
+		t.Errorf("Expected error registering a built-in name")
+	}
+}
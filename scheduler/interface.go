@@ -0,0 +1,13 @@
+package scheduler
+
+// IScheduler decides the learning rate used in every training epoch.
+//
+// Rate is called once at the start of each epoch with the zero-based epoch
+// number, counted across resumed runs, the base learning rate of the
+// optimizer, and the loss of every epoch trained so far, including the
+// ones of previous runs of a loaded profile. It returns the learning rate
+// to use for that epoch.
+type IScheduler interface {
+	Rate(epoch int, base float64, losses []float64) float64
+	CallMe() string
+}
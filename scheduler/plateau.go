@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"math"
+)
+
+// ReduceOnPlateau lowers the learning rate by Factor whenever the training
+// loss has not improved by at least MinDelta for Patience epochs. After a
+// reduction it waits Cooldown epochs before it starts counting again, and it
+// never goes below MinRate.
+//
+// The scheduler is stateful. Its state is exported so that it is saved with
+// the profile and a resumed run keeps the reductions made so far.
+//
+// Fields:
+//   - Factor: Multiplicative factor applied on every reduction.
+//   - Patience: Epochs without improvement tolerated before reducing.
+//   - MinDelta: Minimum decrease of the loss that counts as an improvement.
+//   - Cooldown: Epochs to wait after a reduction before counting again.
+//   - MinRate: Lower bound of the learning rate.
+//   - Best: Lowest loss observed so far.
+//   - Wait: Epochs since the last improvement.
+//   - CooldownLeft: Remaining cooldown epochs.
+//   - Scale: Accumulated reduction applied to the base rate.
+//   - Initialized: Whether the state was set up, so a best loss of exactly 0 is kept.
+type ReduceOnPlateau struct {
+	Factor   float64
+	Patience int
+	MinDelta float64
+	Cooldown int
+	MinRate  float64
+
+	Best         float64
+	Wait         int
+	CooldownLeft int
+	Scale        float64
+	Initialized  bool
+}
+
+func NewReduceOnPlateau(factor float64, patience int) *ReduceOnPlateau {
+	s := &ReduceOnPlateau{
+		Factor:   factor,
+		Patience: patience,
+	}
+	s.initialize()
+	return s
+}
+
+func (s *ReduceOnPlateau) Rate(_ int, base float64, losses []float64) float64 {
+	s.initialize()
+	if len(losses) > 0 {
+		s.observe(losses[len(losses)-1])
+	}
+
+	return math.Max(base*s.Scale, s.MinRate)
+}
+
+// observe updates the plateau state with the loss of the latest epoch.
+func (s *ReduceOnPlateau) observe(loss float64) {
+	if loss < s.Best-s.MinDelta {
+		s.Best = loss
+		s.Wait = 0
+	} else {
+		s.Wait++
+	}
+
+	if s.CooldownLeft > 0 {
+		s.CooldownLeft--
+		s.Wait = 0
+		return
+	}
+
+	if s.Wait >= s.Patience {
+		s.Scale *= s.Factor
+		s.CooldownLeft = s.Cooldown
+		s.Wait = 0
+	}
+}
+
+func (s *ReduceOnPlateau) initialize() {
+	if s.Factor == 0 {
+		s.Factor = float64(0.1)
+	}
+	if s.Patience <= 0 {
+		s.Patience = 10
+	}
+	if !s.Initialized {
+		s.Best = math.MaxFloat64
+		s.Initialized = true
+	}
+	if s.Scale == 0 {
+		s.Scale = 1
+	}
+}
+
+func (s *ReduceOnPlateau) CallMe() string {
+	return "plateau"
+}
@@ -0,0 +1,32 @@
+package scheduler
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRateReduceOnPlateau(t *testing.T) {
+	scheduler := NewReduceOnPlateau(0.5, 2)
+	losses := []float64{0.5, 0.4, 0.4, 0.4, 0.3, 0.3, 0.3}
+	expected := []float64{0.1, 0.1, 0.1, 0.05, 0.05, 0.05, 0.025}
+
+	for epoch := range losses {
+		got := scheduler.Rate(epoch, 0.1, losses[:epoch+1])
+		if math.Abs(got-expected[epoch]) > 0.0001 {
+			t.Errorf("Epoch %d: expected %f, get %f", epoch, expected[epoch], got)
+		}
+	}
+}
+
+func TestRateReduceOnPlateauZeroLoss(t *testing.T) {
+	scheduler := NewReduceOnPlateau(0.5, 2)
+	losses := []float64{0, 0, 0}
+	expected := []float64{0.1, 0.1, 0.05}
+
+	for epoch := range losses {
+		got := scheduler.Rate(epoch, 0.1, losses[:epoch+1])
+		if math.Abs(got-expected[epoch]) > 0.0001 {
+			t.Errorf("Epoch %d: expected %f, get %f", epoch, expected[epoch], got)
+		}
+	}
+}
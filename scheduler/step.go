@@ -0,0 +1,41 @@
+package scheduler
+
+import "math"
+
+// StepDecay multiplies the learning rate by Gamma every StepSize epochs,
+// producing a staircase-shaped schedule.
+//
+// Fields:
+//   - StepSize: Number of epochs between two decays.
+//   - Gamma: Multiplicative factor applied at every step.
+type StepDecay struct {
+	StepSize int
+	Gamma    float64
+}
+
+func NewStepDecay(stepSize int, gamma float64) *StepDecay {
+	s := &StepDecay{
+		StepSize: stepSize,
+		Gamma:    gamma,
+	}
+	s.initialize()
+	return s
+}
+
+func (s *StepDecay) Rate(epoch int, base float64, _ []float64) float64 {
+	s.initialize()
+	return base * math.Pow(s.Gamma, float64(epoch/s.StepSize))
+}
+
+func (s *StepDecay) initialize() {
+	if s.StepSize <= 0 {
+		s.StepSize = 10
+	}
+	if s.Gamma == 0 {
+		s.Gamma = float64(0.1)
+	}
+}
+
+func (s *StepDecay) CallMe() string {
+	return "step"
+}
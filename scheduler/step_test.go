@@ -0,0 +1,26 @@
+package scheduler
+
+import (
+	"math"
+	"testing"
+)
+
+func getStepDecayExpectedValues() map[int]float64 {
+	return map[int]float64{
+		0:  0.1,
+		9:  0.1,
+		10: 0.05,
+		25: 0.025,
+	}
+}
+
+func TestRateStepDecay(t *testing.T) {
+	scheduler := NewStepDecay(10, 0.5)
+	vals := getStepDecayExpectedValues()
+	for epoch, expected := range vals {
+		got := scheduler.Rate(epoch, 0.1, nil)
+		if math.Abs(got-expected) > 0.0001 {
+			t.Errorf("Expected %f, get %f", expected, got)
+		}
+	}
+}
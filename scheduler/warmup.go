@@ -0,0 +1,44 @@
+package scheduler
+
+// LinearWarmup ramps the learning rate linearly from StartFactor * base up to
+// the base rate over the first Epochs epochs and keeps it there afterwards.
+// Warming up avoids large, destabilizing updates while adaptive optimizers
+// are still collecting their statistics.
+//
+// Fields:
+//   - Epochs: Number of warmup epochs.
+//   - StartFactor: Fraction of the base rate used in the first epoch. Zero warms up from a
+//     learning rate of zero.
+type LinearWarmup struct {
+	Epochs      int
+	StartFactor float64
+}
+
+func NewLinearWarmup(epochs int, startFactor float64) *LinearWarmup {
+	s := &LinearWarmup{
+		Epochs:      epochs,
+		StartFactor: startFactor,
+	}
+	s.initialize()
+	return s
+}
+
+func (s *LinearWarmup) Rate(epoch int, base float64, _ []float64) float64 {
+	s.initialize()
+	if epoch >= s.Epochs {
+		return base
+	}
+
+	progress := float64(epoch) / float64(s.Epochs)
+	return base * (s.StartFactor + (1-s.StartFactor)*progress)
+}
+
+func (s *LinearWarmup) initialize() {
+	if s.Epochs <= 0 {
+		s.Epochs = 5
+	}
+}
+
+func (s *LinearWarmup) CallMe() string {
+	return "warmup"
+}
@@ -0,0 +1,30 @@
+package scheduler
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRateLinearWarmup(t *testing.T) {
+	scheduler := NewLinearWarmup(4, 0.2)
+	expected := []float64{0.02, 0.04, 0.06, 0.08, 0.1, 0.1}
+
+	for epoch, want := range expected {
+		got := scheduler.Rate(epoch, 0.1, nil)
+		if math.Abs(got-want) > 0.0001 {
+			t.Errorf("Epoch %d: expected %f, get %f", epoch, want, got)
+		}
+	}
+}
+
+func TestRateLinearWarmupFromZero(t *testing.T) {
+	scheduler := NewLinearWarmup(4, 0)
+	expected := []float64{0, 0.025, 0.05, 0.075, 0.1}
+
+	for epoch, want := range expected {
+		got := scheduler.Rate(epoch, 0.1, nil)
+		if math.Abs(got-want) > 0.0001 {
+			t.Errorf("Epoch %d: expected %f, get %f", epoch, want, got)
+		}
+	}
+}